@@ -5,47 +5,9 @@ import (
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
-)
-
-// func mapIfWellKnownValue(v Value) Value {
-// 	return v // TODO: do mapping with well known types
-// }
-
-// func Get(container Container, path Path) (any, error) {
-// 	err := error(nil)
-// 	last := path.Last()
-// 	if !last.IsFirst() {
-// 		if container, err = Access(container, last.PrecedingPath()); err != nil {
-// 			return nil, err
-// 		}
-// 	}
-// 	value, err := container.Get(last.Value())
-// 	if err != nil {
-// 		if !last.IsFirst() {
-// 			return nil, NewErrInPath(string(last.PrecedingPath()), err)
-// 		}
-// 		return nil, err
-// 	}
-// 	return value, err
-// }
 
-// func Mutable(container Container, path Path) (any, error) {
-// 	err := error(nil)
-// 	last := path.Last()
-// 	if !last.IsFirst() {
-// 		if container, err = AccessMutable(container, last.PrecedingPath()); err != nil {
-// 			return nil, err
-// 		}
-// 	}
-// 	value, err := container.Mutable(last.Value())
-// 	if err != nil {
-// 		if !last.IsFirst() {
-// 			return nil, NewErrInPath(string(last.PrecedingPath()), err)
-// 		}
-// 		return nil, err
-// 	}
-// 	return value, err
-// }
+	"github.com/daishe/protopatch/internal/protoops"
+)
 
 func Access(container Container, path Path, opts ...Option) (Container, error) {
 	return access(container, path, newSetup(opts...))
@@ -66,6 +28,17 @@ func access(container Container, path Path, setup *setup) (Container, error) {
 	return container, nil
 }
 
+// accessParent descends into the container holding the value pointed by the last segment of the provided non-empty path and returns it together with that segment.
+func accessParent(base proto.Message, path Path, setup *setup) (Container, PathSegment, error) {
+	last := path.Last()
+	if last.IsFirst() { // path has only 1 element
+		c, err := transformContainer(MessageContainer(base), setup)
+		return c, last, err
+	}
+	c, err := access(MessageContainer(base), last.PrecedingPath(), setup)
+	return c, last, err
+}
+
 func accessOnce(c Container, ps PathSegment, setup *setup) (Container, error) {
 	next, err := c.Access(ps.Value())
 	if err != nil {
@@ -175,21 +148,46 @@ func copyMap(src, dst Map) {
 	}
 }
 
+// getNewRef returns a zero value of the type of value associated with the given field / index / key, to be used as a conversion target. Containers managed by this package return enums as protoreflect.Enum values carrying their enum descriptors (see EnumConverter), all other containers return values of their GetNew method.
+func getNewRef(c Container, key string) (any, error) {
+	if rc, ok := c.(interface{ getNewRef(string) (any, error) }); ok {
+		return rc.getNewRef(key)
+	}
+	return c.GetNew(key)
+}
+
 func (c *messageContainer) GetNew(key string) (any, error) {
 	field, err := fieldInMessage(c.msg.Descriptor().Fields(), key)
 	if err != nil {
 		return nil, err
 	}
+	return protoops.EnumNumberOf(c.getNewField(field)), nil
+}
+
+// getNewRef works like GetNew, but returns enums as protoreflect.Enum values carrying their enum descriptors (see getNewRef function).
+func (c *messageContainer) getNewRef(key string) (any, error) {
+	field, err := fieldInMessage(c.msg.Descriptor().Fields(), key)
+	if err != nil {
+		return nil, err
+	}
+	return c.getNewField(field), nil
+}
+
+// getNewField works like getNewRef, but with already resolved field.
+func (c *messageContainer) getNewField(field protoreflect.FieldDescriptor) any {
 	if field.IsList() {
-		return NewList(field, c.msg.NewField(field).List()), nil
+		return NewList(field, c.msg.NewField(field).List())
 	}
 	if field.IsMap() {
-		return NewMap(field, c.msg.NewField(field).Map()), nil
+		return NewMap(field, c.msg.NewField(field).Map())
 	}
 	if field.Kind() == protoreflect.MessageKind {
-		return proto.Clone(c.msg.NewField(field).Message().Interface()), nil
+		return proto.Clone(c.msg.NewField(field).Message().Interface())
 	}
-	return c.msg.Get(field).Interface(), nil
+	if field.Kind() == protoreflect.EnumKind {
+		return protoops.NewEnum(field.Enum(), field.Default().Enum())
+	}
+	return c.msg.Get(field).Interface()
 }
 
 func (c *messageContainer) Mutable(key string) (any, error) {
@@ -200,6 +198,12 @@ func (c *messageContainer) Mutable(key string) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := c.guard.field(field); err != nil {
+		return nil, err
+	}
+	if err := c.guard.oneof(c.msg, field); err != nil {
+		return nil, err
+	}
 	if field.IsList() {
 		return NewList(field, c.msg.Mutable(field).List()), nil
 	}
@@ -220,15 +224,26 @@ func (c *messageContainer) Access(key string) (Container, error) {
 	if err != nil {
 		return nil, err
 	}
-	ro := c.ro || (field.HasPresence() && !c.msg.Has(field))
+	return c.accessField(field)
+}
+
+// accessField works like Access, but with already resolved field.
+func (c *messageContainer) accessField(field protoreflect.FieldDescriptor) (Container, error) {
+	ro, guard := c.ro || (field.HasPresence() && !c.msg.Has(field)), c.guard.enter(field)
 	if field.IsList() {
-		return newListContainer(c.msg, field, c.msg.Get(field).List(), ro), nil
+		next := newListContainer(c.msg, field, c.msg.Get(field).List(), ro)
+		next.guard = guard
+		return next, nil
 	}
 	if field.IsMap() {
-		return newMapContainer(c.msg, field, c.msg.Get(field).Map(), ro), nil
+		next := newMapContainer(c.msg, field, c.msg.Get(field).Map(), ro)
+		next.guard = guard
+		return next, nil
 	}
 	if field.Kind() == protoreflect.MessageKind {
-		return newMessageContainer(c.msg.Get(field).Message(), ro), nil
+		next := newMessageContainer(c.msg.Get(field).Message(), ro)
+		next.guard = guard
+		return next, nil
 	}
 	return nil, ErrAccessToNonContainer
 }
@@ -241,14 +256,26 @@ func (c *messageContainer) AccessMutable(key string) (Container, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := c.guard.field(field); err != nil {
+		return nil, err
+	}
+	if err := c.guard.oneof(c.msg, field); err != nil {
+		return nil, err
+	}
 	if field.IsList() {
-		return newListContainer(c.msg, field, c.msg.Mutable(field).List(), false), nil
+		next := newListContainer(c.msg, field, c.msg.Mutable(field).List(), false)
+		next.guard = c.guard
+		return next, nil
 	}
 	if field.IsMap() {
-		return newMapContainer(c.msg, field, c.msg.Mutable(field).Map(), false), nil
+		next := newMapContainer(c.msg, field, c.msg.Mutable(field).Map(), false)
+		next.guard = c.guard
+		return next, nil
 	}
 	if field.Kind() == protoreflect.MessageKind {
-		return newMessageContainer(c.msg.Mutable(field).Message(), false), nil
+		next := newMessageContainer(c.msg.Mutable(field).Message(), false)
+		next.guard = c.guard
+		return next, nil
 	}
 	if field.HasPresence() && !c.msg.Has(field) {
 		c.msg.Set(field, c.msg.NewField(field))
@@ -316,7 +343,7 @@ func (c *listContainer) Self() any {
 }
 
 func (c *listContainer) Get(key string) (any, error) {
-	idx, err := indexInList(c.li, key)
+	idx, err := c.index(key)
 	if err != nil {
 		return nil, err
 	}
@@ -327,7 +354,7 @@ func (c *listContainer) Get(key string) (any, error) {
 }
 
 func (c *listContainer) GetCopy(key string) (any, error) {
-	idx, err := indexInList(c.li, key)
+	idx, err := c.index(key)
 	if err != nil {
 		return nil, err
 	}
@@ -338,21 +365,36 @@ func (c *listContainer) GetCopy(key string) (any, error) {
 }
 
 func (c *listContainer) GetNew(key string) (any, error) {
+	ref, err := c.getNewRef(key)
+	return protoops.EnumNumberOf(ref), err
+}
+
+// getNewRef works like GetNew, but returns enums as protoreflect.Enum values carrying their enum descriptors (see getNewRef function).
+func (c *listContainer) getNewRef(key string) (any, error) {
 	_, err := parseListIndex(key)
+	if isPathFilter(key) {
+		_, err = ParsePathFilter(key)
+	}
+	if key == PathEndOfList { // position of an appended or inserted item
+		err = nil
+	}
 	if err != nil {
 		return nil, err
 	}
 	if c.parentField.Kind() == protoreflect.MessageKind {
 		return c.li.NewElement().Message().Interface(), nil
 	}
-	return c.li.NewElement().Interface(), nil
+	return protoops.EnumRef(c.parentField.Enum(), c.li.NewElement().Interface()), nil
 }
 
 func (c *listContainer) Mutable(key string) (any, error) {
 	if c.ro {
 		return nil, ErrMutationOfReadOnlyValue
 	}
-	idx, err := indexInList(c.li, key)
+	if err := c.guard.mutable(); err != nil {
+		return nil, err
+	}
+	idx, err := c.index(key)
 	if err != nil {
 		return nil, err
 	}
@@ -363,28 +405,35 @@ func (c *listContainer) Mutable(key string) (any, error) {
 }
 
 func (c *listContainer) Access(key string) (Container, error) {
-	idx, err := indexInList(c.li, key)
+	idx, err := c.index(key)
 	if err != nil {
 		return nil, err
 	}
 	if c.parentField.Kind() != protoreflect.MessageKind {
 		return nil, ErrAccessToNonContainer
 	}
-	return newMessageContainer(c.li.Get(idx).Message(), c.ro), nil
+	next := newMessageContainer(c.li.Get(idx).Message(), c.ro)
+	next.guard = c.guard
+	return next, nil
 }
 
 func (c *listContainer) AccessMutable(key string) (Container, error) {
 	if c.ro {
 		return nil, ErrMutationOfReadOnlyValue
 	}
-	idx, err := indexInList(c.li, key)
+	if err := c.guard.mutable(); err != nil {
+		return nil, err
+	}
+	idx, err := c.index(key)
 	if err != nil {
 		return nil, err
 	}
 	if c.parentField.Kind() != protoreflect.MessageKind {
 		return nil, ErrAccessToNonContainer
 	}
-	return newMessageContainer(c.li.Get(idx).Message(), c.ro), nil
+	next := newMessageContainer(c.li.Get(idx).Message(), c.ro)
+	next.guard = c.guard
+	return next, nil
 }
 
 // func (v *listElementValue) AccessReadOnly(name string) (Value, error) {
@@ -428,6 +477,12 @@ func (c *mapContainer) GetCopy(key string) (any, error) {
 }
 
 func (c *mapContainer) GetNew(key string) (any, error) {
+	ref, err := c.getNewRef(key)
+	return protoops.EnumNumberOf(ref), err
+}
+
+// getNewRef works like GetNew, but returns enums as protoreflect.Enum values carrying their enum descriptors (see getNewRef function).
+func (c *mapContainer) getNewRef(key string) (any, error) {
 	_, err := parseMapKey(c.parentField.MapKey(), key)
 	if err != nil {
 		return nil, err
@@ -435,13 +490,16 @@ func (c *mapContainer) GetNew(key string) (any, error) {
 	if c.parentField.MapValue().Kind() == protoreflect.MessageKind {
 		return c.ma.NewValue().Message().Interface(), nil
 	}
-	return c.ma.NewValue().Interface(), nil
+	return protoops.EnumRef(c.parentField.MapValue().Enum(), c.ma.NewValue().Interface()), nil
 }
 
 func (c *mapContainer) Mutable(key string) (any, error) {
 	if c.ro {
 		return nil, ErrMutationOfReadOnlyValue
 	}
+	if err := c.guard.mutable(); err != nil {
+		return nil, err
+	}
 	mk, err := keyInMap(c.ma, c.parentField.MapKey(), key)
 	if err != nil {
 		return nil, err
@@ -460,13 +518,18 @@ func (c *mapContainer) Access(key string) (Container, error) {
 	if c.parentField.MapValue().Kind() != protoreflect.MessageKind {
 		return nil, ErrAccessToNonContainer
 	}
-	return newMessageContainer(c.ma.Get(mk).Message(), c.ro), nil
+	next := newMessageContainer(c.ma.Get(mk).Message(), c.ro)
+	next.guard = c.guard
+	return next, nil
 }
 
 func (c *mapContainer) AccessMutable(key string) (Container, error) {
 	if c.ro {
 		return nil, ErrMutationOfReadOnlyValue
 	}
+	if err := c.guard.mutable(); err != nil {
+		return nil, err
+	}
 	mk, err := keyInMap(c.ma, c.parentField.MapKey(), key)
 	if err != nil {
 		return nil, err
@@ -474,7 +537,9 @@ func (c *mapContainer) AccessMutable(key string) (Container, error) {
 	if c.parentField.MapValue().Kind() != protoreflect.MessageKind {
 		return nil, ErrAccessToNonContainer
 	}
-	return newMessageContainer(c.ma.Get(mk).Message(), false), nil
+	next := newMessageContainer(c.ma.Get(mk).Message(), false)
+	next.guard = c.guard
+	return next, nil
 }
 
 // func (v *mapElementValue) AccessReadOnly(name string) (Value, error) {
@@ -5,6 +5,8 @@ import (
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daishe/protopatch/internal/protoops"
 )
 
 func Append(base proto.Message, path string, new any, opts ...Option) error {
@@ -12,10 +14,18 @@ func Append(base proto.Message, path string, new any, opts ...Option) error {
 }
 
 func appendWithSetup(base proto.Message, path string, new any, setup *setup) error {
+	if err := checkPath(base, path, setup); err != nil {
+		return err
+	}
 	if path == "" { // special case - an empty path; append to the base message
 		return ErrAppendToNonList
 	}
 	p := Path(path)
+	if p.HasWildcard() {
+		return forEachExpandedPath(base, p, false, setup, func(p Path) error {
+			return appendWithSetup(base, string(p), valueForEach(new), setup)
+		})
+	}
 	a, err := access(MessageContainer(base), p, setup)
 	if err != nil {
 		return err
@@ -36,6 +46,9 @@ func appendWithSetup(base proto.Message, path string, new any, setup *setup) err
 }
 
 func newElementForAppendInContainer(c Container) (any, error) {
+	if !isOwnContainer(c) { // containers not managed by this package know their elements best
+		return c.GetNew(PathEndOfList)
+	}
 	li, ok := c.Self().(List)
 	if !ok {
 		return nil, ErrAppendToNonList
@@ -43,7 +56,7 @@ func newElementForAppendInContainer(c Container) (any, error) {
 	if li.ParentFieldDescriptor().Kind() == protoreflect.MessageKind {
 		return li.NewElement().Message().Interface(), nil
 	}
-	return li.NewElement().Interface(), nil
+	return protoops.EnumRef(li.ParentFieldDescriptor().Enum(), li.NewElement().Interface()), nil
 }
 
 func (c *messageContainer) Append(new any) error {
@@ -57,6 +70,9 @@ func (c *listContainer) Append(new any) error {
 	if c.ro {
 		return ErrMutationOfReadOnlyValue
 	}
+	if err := c.guard.mutable(); err != nil {
+		return err
+	}
 	if c.parentField.Kind() == protoreflect.MessageKind {
 		pr := asProtoreflectMessage(new)
 		if pr == nil {
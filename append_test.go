@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/daishe/protopatch"
 	"github.com/daishe/protopatch/internal/patchtest"
@@ -44,6 +45,20 @@ func TestAppend(t *testing.T) {
 			value: "bbb",
 			want:  &protopatchv1.TestList{String_: []string{"aaa", "bbb"}},
 		},
+		{
+			name:  "enum-list/append-number",
+			base:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+			path:  "enum",
+			value: protoreflect.EnumNumber(1),
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED, protopatchv1.Enum_ENUM_VALUE_OTHER}},
+		},
+		{
+			name:  "enum-list/append-generated",
+			base:  &protopatchv1.TestList{},
+			path:  "enum",
+			value: protopatchv1.Enum_ENUM_VALUE_OTHER,
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER}},
+		},
 		{
 			name:  "message-list-nil/append",
 			base:  &protopatchv1.TestList{Message: []*protopatchv1.TestMessage(nil)},
@@ -1,15 +1,26 @@
 package protopatch
 
-import "google.golang.org/protobuf/proto"
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
 
 func Clear(base proto.Message, path string, opts ...Option) error {
 	return clearWithSetup(base, path, newSetup(opts...))
 }
 
 func clearWithSetup(base proto.Message, path string, setup *setup) error {
+	if err := checkPath(base, path, setup); err != nil {
+		return err
+	}
 	if path == "" { // special case - an empty path; clear of the base message
 		return clearSelf(base, setup)
 	}
+	if Path(path).HasWildcard() { // clear in reverse order, so that removal of list items does not shift indexes of the remaining ones
+		return forEachExpandedPath(base, Path(path), true, setup, func(p Path) error {
+			return clearWithSetup(base, string(p), setup)
+		})
+	}
 
 	c := MessageContainer(base)
 	p := Path(path)
@@ -31,7 +42,7 @@ func clearWithSetup(base proto.Message, path string, setup *setup) error {
 	if err != nil {
 		return err
 	}
-	err = a.Set(path, nil)
+	err = a.Set(p.Last().Value(), nil)
 	if err != nil {
 		return err
 	}
@@ -40,6 +51,7 @@ func clearWithSetup(base proto.Message, path string, setup *setup) error {
 
 func clearSelf(base proto.Message, setup *setup) error {
 	c := MessageContainer(base).(*messageContainer)
+	guardContainer(c, setup)
 	return c.setSelf(nil)
 }
 
@@ -47,6 +59,9 @@ func (c *messageContainer) clearSelf() error {
 	if c.ro {
 		return ErrMutationOfReadOnlyValue
 	}
+	if err := c.guard.replace(c.msg, c.msg.Type().Zero()); err != nil {
+		return err
+	}
 	fields := c.msg.Descriptor().Fields()
 	for i := 0; i < fields.Len(); i++ {
 		c.msg.Clear(fields.Get(i))
@@ -62,6 +77,22 @@ func (c *messageContainer) clear(key string) error {
 	if err != nil {
 		return err
 	}
+	return c.clearField(field)
+}
+
+// clearField works like clear, but with already resolved field.
+func (c *messageContainer) clearField(field protoreflect.FieldDescriptor) error {
+	if c.ro {
+		return ErrMutationOfReadOnlyValue
+	}
+	if err := c.guard.field(field); err != nil {
+		return err
+	}
+	if field.Kind() == protoreflect.MessageKind && !field.IsList() && !field.IsMap() {
+		if err := c.guard.replace(c.msg.Get(field).Message(), c.msg.Get(field).Message().Type().Zero()); err != nil {
+			return err
+		}
+	}
 	c.msg.Clear(field)
 	return nil
 }
@@ -70,13 +101,16 @@ func (c *listContainer) clear(key string) error {
 	if c.ro {
 		return ErrMutationOfReadOnlyValue
 	}
-	idx, err := indexInList(c.li, key)
+	if err := c.guard.mutable(); err != nil {
+		return err
+	}
+	idx, err := c.index(key)
 	if err != nil {
 		return err
 	}
 	len := c.li.Len()
 	for i := idx + 1; i < len; i++ {
-		c.li.Set(i-1, c.li.Get(i+1))
+		c.li.Set(i-1, c.li.Get(i))
 	}
 	c.li.Truncate(len - 1)
 	return nil
@@ -86,6 +120,9 @@ func (c *mapContainer) clear(key string) error {
 	if c.ro {
 		return ErrMutationOfReadOnlyValue
 	}
+	if err := c.guard.mutable(); err != nil {
+		return err
+	}
 	mk, err := keyInMap(c.ma, c.parentField.MapKey(), key)
 	if err != nil {
 		return err
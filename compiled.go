@@ -0,0 +1,321 @@
+package protopatch
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daishe/protopatch/internal/protoops"
+)
+
+// CompiledPath is a path validated against and bound to a message descriptor. Fields of messages within the path are resolved once, when the path is compiled, so operations performed with a compiled path skip field lookups by name. Compiled paths are immutable and may be used concurrently.
+//
+// Compiled paths are resolved against the plain structure of messages. Operations provided with ContainerTransformer options, as well as operations with wildcards in their paths, fall back to the regular (uncompiled) path handling.
+type CompiledPath struct {
+	desc     protoreflect.MessageDescriptor
+	path     Path
+	segments []compiledSegment
+	wildcard bool
+}
+
+type compiledSegment struct {
+	key       string                       // unescaped segment value
+	field     protoreflect.FieldDescriptor // resolved field for segments within messages, nil otherwise
+	preceding Path                         // path preceding the segment
+	through   Path                         // path preceding the segment with the segment itself
+}
+
+// Compile validates the path against the provided message descriptor and resolves all fields it refers to. It returns an error if the path refers to a nonexistent field, if a list index or map key is malformed, or if the path descends into a scalar value. List indexes and map keys are not checked for existence, as it depends on the content of patched messages.
+//
+// When ContainerTransformer options are provided, transformed containers may not follow the structure described by the descriptor. In such case the path is resolved only up to the first segment that cannot be resolved and the remaining segments are handled when operations are performed.
+func Compile(desc protoreflect.MessageDescriptor, path string, opts ...Option) (*CompiledPath, error) {
+	cp := &CompiledPath{desc: desc, path: Path(path), wildcard: Path(path).HasWildcard()}
+	if path == "" {
+		return cp, nil
+	}
+	lenient := len(newSetup(opts...).transform) > 0
+	md, collection, resolved := desc, protoreflect.FieldDescriptor(nil), true
+	for ps := range cp.path.Iter {
+		seg := compiledSegment{key: ps.Value(), preceding: ps.PrecedingPath(), through: ps.PrecedingPathWithCurrentSegment()}
+		if resolved {
+			var field protoreflect.FieldDescriptor
+			var err error
+			field, md, collection, err = compileSegment(ps, md, collection)
+			if err != nil && !lenient {
+				return nil, err
+			}
+			if resolved = err == nil; resolved {
+				seg.field = field
+			}
+		}
+		cp.segments = append(cp.segments, seg)
+	}
+	return cp, nil
+}
+
+// compileSegment resolves the segment within the given message, or list / map field. It returns the resolved field (for segments within messages) and the message or list / map field the next segment descends into.
+func compileSegment(ps PathSegment, md protoreflect.MessageDescriptor, collection protoreflect.FieldDescriptor) (protoreflect.FieldDescriptor, protoreflect.MessageDescriptor, protoreflect.FieldDescriptor, error) {
+	wrap := func(err error) error {
+		if ps.IsFirst() {
+			return err
+		}
+		return NewErrInPath(string(ps.PrecedingPath()), err)
+	}
+
+	var field, value protoreflect.FieldDescriptor // resolved field and descriptor of the value pointed by the segment
+	switch {
+	case md != nil:
+		if ps.raw() == PathWildcard {
+			return nil, nil, nil, NewErrInPath(string(ps.PrecedingPathWithCurrentSegment()), ErrWildcardInNonCollection)
+		}
+		f, err := fieldInMessage(md.Fields(), ps.Value())
+		if err != nil {
+			return nil, nil, nil, wrap(err)
+		}
+		if f.IsList() || f.IsMap() {
+			return f, nil, f, nil
+		}
+		field, value = f, f
+	case collection.IsList():
+		if err := compileListKey(ps); err != nil {
+			return nil, nil, nil, wrap(err)
+		}
+		value = collection
+	default:
+		if ps.raw() != PathWildcard && !protoops.ParseMapKey(collection.MapKey(), ps.Value()).IsValid() {
+			return nil, nil, nil, wrap(ErrNotFound{Kind: "key", Value: ps.Value()})
+		}
+		value = collection.MapValue()
+	}
+	if value.Kind() == protoreflect.MessageKind {
+		return field, value.Message(), nil, nil
+	}
+	if !ps.IsLast() {
+		return nil, nil, nil, NewErrInPath(string(ps.PrecedingPathWithCurrentSegment()), ErrAccessToNonContainer)
+	}
+	return field, nil, nil, nil
+}
+
+// compileListKey validates the list key of the given segment.
+func compileListKey(ps PathSegment) error {
+	switch {
+	case ps.raw() == PathWildcard, ps.Value() == PathEndOfList:
+		return nil
+	case ps.IsFilter():
+		_, err := ps.Filter()
+		return err
+	}
+	if _, ok := protoops.ParseListIndex(ps.Value()); !ok {
+		return ErrNotFound{Kind: "index", Value: ps.Value()}
+	}
+	return nil
+}
+
+// MustCompile is like Compile but panics if the path cannot be compiled.
+func MustCompile(desc protoreflect.MessageDescriptor, path string, opts ...Option) *CompiledPath {
+	cp, err := Compile(desc, path, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return cp
+}
+
+// Descriptor returns the message descriptor the path is bound to.
+func (cp *CompiledPath) Descriptor() protoreflect.MessageDescriptor {
+	return cp.desc
+}
+
+// Path returns the compiled path.
+func (cp *CompiledPath) Path() Path {
+	return cp.path
+}
+
+// String returns the compiled path as a string.
+func (cp *CompiledPath) String() string {
+	return string(cp.path)
+}
+
+// Set works like the Set function with the compiled path.
+func (cp *CompiledPath) Set(base proto.Message, to any, opts ...Option) error {
+	setup := newSetup(opts...)
+	if err := cp.check(base); err != nil {
+		return err
+	}
+	if !cp.fast(setup) {
+		return setWithSetup(base, string(cp.path), to, setup)
+	}
+	if to == nil {
+		return cp.clear(base)
+	}
+	a, last, err := cp.parent(base)
+	if err != nil {
+		return err
+	}
+	ref, err := last.getNew(a)
+	if err != nil {
+		return last.wrap(err)
+	}
+	conv, err := convert(ref, to, setup)
+	if err != nil {
+		return NewErrInPath(string(last.through), err)
+	}
+	return last.wrap(last.set(a, conv))
+}
+
+// Clear works like the Clear function with the compiled path.
+func (cp *CompiledPath) Clear(base proto.Message, opts ...Option) error {
+	setup := newSetup(opts...)
+	if err := cp.check(base); err != nil {
+		return err
+	}
+	if !cp.fast(setup) {
+		return clearWithSetup(base, string(cp.path), setup)
+	}
+	return cp.clear(base)
+}
+
+func (cp *CompiledPath) clear(base proto.Message) error {
+	a, last, err := cp.parent(base)
+	if err != nil {
+		return err
+	}
+	return last.wrap(last.set(a, nil))
+}
+
+// Append works like the Append function with the compiled path.
+func (cp *CompiledPath) Append(base proto.Message, new any, opts ...Option) error {
+	setup := newSetup(opts...)
+	if err := cp.check(base); err != nil {
+		return err
+	}
+	if !cp.fast(setup) {
+		return appendWithSetup(base, string(cp.path), new, setup)
+	}
+	a, last, err := cp.parent(base)
+	if err != nil {
+		return err
+	}
+	if a, err = last.access(a); err != nil {
+		return last.wrapAccess(err)
+	}
+	ref, err := newElementForAppendInContainer(a)
+	if err != nil {
+		return NewErrInPath(string(cp.path), err)
+	}
+	conv, err := convert(ref, new, setup)
+	if err != nil {
+		return NewErrInPath(string(cp.path.Join("*")), err)
+	}
+	if err := a.Append(conv); err != nil {
+		return NewErrInPath(string(cp.path), err)
+	}
+	return nil
+}
+
+// Insert works like the Insert function with the compiled path.
+func (cp *CompiledPath) Insert(base proto.Message, new any, opts ...Option) error {
+	setup := newSetup(opts...)
+	if err := cp.check(base); err != nil {
+		return err
+	}
+	if !cp.fast(setup) {
+		return insertWithSetup(base, string(cp.path), new, setup)
+	}
+	a, last, err := cp.parent(base)
+	if err != nil {
+		return err
+	}
+	ref, err := newElementForInsertInContainer(a, last.key)
+	if err != nil {
+		return last.wrap(err)
+	}
+	conv, err := convert(ref, new, setup)
+	if err != nil {
+		return NewErrInPath(string(last.through), err)
+	}
+	return last.wrap(a.Insert(last.key, conv))
+}
+
+// Access works like the Access function with the compiled path, starting from the base message.
+func (cp *CompiledPath) Access(base proto.Message, opts ...Option) (Container, error) {
+	setup := newSetup(opts...)
+	if err := cp.check(base); err != nil {
+		return nil, err
+	}
+	if !cp.fast(setup) {
+		return access(MessageContainer(base), cp.path, setup)
+	}
+	a, last, err := cp.parent(base)
+	if err != nil {
+		return nil, err
+	}
+	if a, err = last.access(a); err != nil {
+		return nil, last.wrapAccess(err)
+	}
+	return a, nil
+}
+
+// check reports an error if the base message is not of the type the path is bound to.
+func (cp *CompiledPath) check(base proto.Message) error {
+	if base.ProtoReflect().Descriptor() != cp.desc {
+		return ErrMismatchingType
+	}
+	return nil
+}
+
+// fast reports whether the operation can be performed with resolved fields.
+func (cp *CompiledPath) fast(setup *setup) bool {
+	return cp.path != "" && !cp.wildcard && len(setup.transform) == 0 && len(setup.policy) == 0 && !setup.fieldBehavior
+}
+
+// parent descends into the container holding the value pointed by the last segment of the path and returns it together with that segment.
+func (cp *CompiledPath) parent(base proto.Message) (Container, compiledSegment, error) {
+	c := MessageContainer(base)
+	last := len(cp.segments) - 1
+	for _, seg := range cp.segments[:last] {
+		next, err := seg.access(c)
+		if err != nil {
+			return nil, compiledSegment{}, seg.wrapAccess(err)
+		}
+		c = next
+	}
+	return c, cp.segments[last], nil
+}
+
+func (seg compiledSegment) access(c Container) (Container, error) {
+	if mc, ok := c.(*messageContainer); ok && seg.field != nil {
+		return mc.accessField(seg.field)
+	}
+	return c.Access(seg.key)
+}
+
+func (seg compiledSegment) getNew(c Container) (any, error) {
+	if mc, ok := c.(*messageContainer); ok && seg.field != nil {
+		return mc.getNewField(seg.field), nil
+	}
+	return getNewRef(c, seg.key)
+}
+
+func (seg compiledSegment) set(c Container, to any) error {
+	if mc, ok := c.(*messageContainer); ok && seg.field != nil {
+		return mc.setField(seg.key, seg.field, to)
+	}
+	return c.Set(seg.key, to)
+}
+
+// wrap describes error returned by the container holding the value pointed by the segment, as done for regular paths.
+func (seg compiledSegment) wrap(err error) error {
+	if err == nil || seg.preceding == "" {
+		return err
+	}
+	return NewErrInPath(string(seg.preceding), err)
+}
+
+// wrapAccess describes error returned when descending into the segment, as done by Access.
+func (seg compiledSegment) wrapAccess(err error) error {
+	if errors.Is(err, ErrAccessToNonContainer) {
+		return NewErrInPath(string(seg.through), err)
+	}
+	return seg.wrap(err)
+}
@@ -0,0 +1,177 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestCompiledPath(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestMessage{
+		String_: "a",
+		Message: &protopatchv1.TestMessage{Int32: 1},
+		List:    &protopatchv1.TestList{String_: []string{"a", "b"}, Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b"}}},
+		Map:     &protopatchv1.TestMap{StringToMessage: map[string]*protopatchv1.TestMessage{"a.b": {String_: "a"}}},
+		WellKnown: &protopatchv1.TestWellKnown{
+			Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("a")}}),
+		},
+	}
+
+	tests := []struct {
+		name  string
+		op    protopatch.OpKind
+		path  string
+		value any
+		opts  []protopatch.Option
+	}{
+		{name: "set-scalar", op: protopatch.OpSet, path: "string", value: "x"},
+		{name: "set-nested-scalar", op: protopatch.OpSet, path: "message.int32", value: int32(5)},
+		{name: "set-in-unset-message", op: protopatch.OpSet, path: "oneof.string", value: "x"},
+		{name: "set-with-conversion", op: protopatch.OpSet, path: "message.int64", value: structpb.NewNumberValue(5), opts: []protopatch.Option{protopatch.WithConversion(patchstructpb.FromValueConverter())}},
+		{name: "set-mismatching-type", op: protopatch.OpSet, path: "message.int32", value: "x"},
+		{name: "set-list-item", op: protopatch.OpSet, path: "list.string.-1", value: "x"},
+		{name: "set-list-item-field", op: protopatch.OpSet, path: "list.message.1.string", value: "x"},
+		{name: "set-out-of-range", op: protopatch.OpSet, path: "list.message.5.string", value: "x"},
+		{name: "set-filtered-item-field", op: protopatch.OpSet, path: "list.message[string=b].int32", value: int32(1)},
+		{name: "set-map-value-field", op: protopatch.OpSet, path: `map.stringToMessage.a\.b.int32`, value: int32(1)},
+		{name: "set-new-map-key", op: protopatch.OpSet, path: "map.stringToMessage.c", value: &protopatchv1.TestMessage{String_: "c"}},
+		{name: "set-missing-map-key", op: protopatch.OpSet, path: "map.stringToMessage.c.string", value: "c"},
+		{name: "set-nil", op: protopatch.OpSet, path: "message", value: nil},
+		{name: "set-wildcard", op: protopatch.OpSet, path: "list.message.*.int32", value: int32(2)},
+		{name: "set-transformed", op: protopatch.OpSet, path: "wellKnown.value.a", value: structpb.NewBoolValue(true), opts: []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())}},
+		{name: "set-base", op: protopatch.OpSet, path: "", value: &protopatchv1.TestMessage{String_: "x"}},
+		{name: "clear-field", op: protopatch.OpClear, path: "message.int32"},
+		{name: "clear-list-item", op: protopatch.OpClear, path: "list.string.0"},
+		{name: "clear-map-key", op: protopatch.OpClear, path: `map.stringToMessage["a.b"]`},
+		{name: "clear-in-unset-message", op: protopatch.OpClear, path: "oneof.string"},
+		{name: "append", op: protopatch.OpAppend, path: "list.string", value: "x"},
+		{name: "append-to-non-list", op: protopatch.OpAppend, path: "message", value: "x"},
+		{name: "insert", op: protopatch.OpInsert, path: "list.message.0", value: &protopatchv1.TestMessage{String_: "x"}},
+		{name: "insert-at-end", op: protopatch.OpInsert, path: "list.string.-", value: "x"},
+		{name: "insert-to-non-list", op: protopatch.OpInsert, path: "message.string", value: "x"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cp, err := protopatch.Compile(base.ProtoReflect().Descriptor(), test.path, test.opts...)
+			require.NoError(t, err)
+			require.Equal(t, protopatch.Path(test.path), cp.Path())
+
+			want, got := proto.Clone(base), proto.Clone(base)
+			wantErr, gotErr := error(nil), error(nil)
+			switch test.op {
+			case protopatch.OpSet:
+				wantErr, gotErr = protopatch.Set(want, test.path, test.value, test.opts...), cp.Set(got, test.value, test.opts...)
+			case protopatch.OpClear:
+				wantErr, gotErr = protopatch.Clear(want, test.path, test.opts...), cp.Clear(got, test.opts...)
+			case protopatch.OpAppend:
+				wantErr, gotErr = protopatch.Append(want, test.path, test.value, test.opts...), cp.Append(got, test.value, test.opts...)
+			case protopatch.OpInsert:
+				wantErr, gotErr = protopatch.Insert(want, test.path, test.value, test.opts...), cp.Insert(got, test.value, test.opts...)
+			}
+			require.Equal(t, wantErr, gotErr)
+			patchtest.RequireEqual(t, want, got, "message after operation with compiled path mismatch")
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	desc := (&protopatchv1.TestMessage{}).ProtoReflect().Descriptor()
+
+	tests := []struct {
+		path    string
+		wantErr error
+	}{
+		{path: ""},
+		{path: "message.message.string"},
+		{path: "list.message.0.list.string.*"},
+		{path: "list.message[string=a].string"},
+		{path: "list.string.-"},
+		{path: "map.int32ToString.-5"},
+		{path: "map.stringToMessage.*.string"},
+		{path: "unknown", wantErr: protopatch.ErrNotFound{Kind: "field", Value: "unknown"}},
+		{path: "message.99", wantErr: protopatch.NewErrInPath("message", protopatch.ErrNotFound{Kind: "field", Value: "99"})},
+		{path: "message.*", wantErr: protopatch.NewErrInPath("message.*", protopatch.ErrWildcardInNonCollection)},
+		{path: "string.length", wantErr: protopatch.NewErrInPath("string", protopatch.ErrAccessToNonContainer)},
+		{path: "list.string.0.length", wantErr: protopatch.NewErrInPath("list.string.0", protopatch.ErrAccessToNonContainer)},
+		{path: "list.string.a", wantErr: protopatch.NewErrInPath("list.string", protopatch.ErrNotFound{Kind: "index", Value: "a"})},
+		{path: "list.message[string]", wantErr: protopatch.NewErrInPath("list.message", protopatch.ErrInvalidFilter{Filter: "[string]"})},
+		{path: "map.int32ToString.a", wantErr: protopatch.NewErrInPath("map.int32ToString", protopatch.ErrNotFound{Kind: "key", Value: "a"})},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			t.Parallel()
+			cp, err := protopatch.Compile(desc, test.path)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				require.Panics(t, func() { protopatch.MustCompile(desc, test.path) })
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.path, cp.String())
+			require.Equal(t, desc, cp.Descriptor())
+		})
+	}
+}
+
+func TestCompiledPathMismatchingType(t *testing.T) {
+	t.Parallel()
+
+	cp := protopatch.MustCompile((&protopatchv1.TestMessage{}).ProtoReflect().Descriptor(), "string")
+	require.Equal(t, protopatch.ErrMismatchingType, cp.Set(&protopatchv1.TestList{}, "x"))
+	require.Equal(t, protopatch.ErrMismatchingType, cp.Clear(&protopatchv1.TestList{}))
+}
+
+func TestCompileWithContainerTransformation(t *testing.T) {
+	t.Parallel()
+
+	desc := (&protopatchv1.TestMessage{}).ProtoReflect().Descriptor()
+	_, err := protopatch.Compile(desc, "wellKnown.value.a")
+	require.Equal(t, protopatch.NewErrInPath("wellKnown.value", protopatch.ErrNotFound{Kind: "field", Value: "a"}), err)
+
+	require.Panics(t, func() { protopatch.MustCompile(desc, "wellKnown.value.a") })
+
+	opt := protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())
+	cp, err := protopatch.Compile(desc, "wellKnown.value.a", opt)
+	require.NoError(t, err)
+	require.NotPanics(t, func() { protopatch.MustCompile(desc, "wellKnown.value.a", opt) })
+	got := &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{Value: structpb.NewStructValue(&structpb.Struct{})}}
+	require.NoError(t, cp.Set(got, structpb.NewStringValue("a"), opt))
+	patchtest.RequireEqual(t, &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+		Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("a")}}),
+	}}, got, "message after set mismatch")
+}
+
+func BenchmarkCompiledPathSet(b *testing.B) {
+	msg := &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{}}}
+	path := "message.message.string"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := protopatch.Set(msg, path, "x"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("compiled", func(b *testing.B) {
+		cp := protopatch.MustCompile(msg.ProtoReflect().Descriptor(), path)
+		for i := 0; i < b.N; i++ {
+			if err := cp.Set(msg, "x"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -60,7 +60,7 @@ type Container interface {
 	// GetCopy returns a copy of value associated with the given field / index / key. It returns error if the field / index / key is not found. For scalar types and messages it returns its value. For lists and maps it returns List and Map interfaces accordingly.
 	GetCopy(string) (any, error)
 
-	// GetNew returns a zero value of the type of value associated with the given field / index / key. It returns error if the field is not found or if index / key is malformed. For scalar types and messages it returns its zero value. For lists and maps it returns List and Map interfaces accordingly without any elements.
+	// GetNew returns a zero value of the type of value associated with the given field / index / key. It returns error if the field is not found or if index / key is malformed. For scalar types and messages it returns its zero value. For lists and maps it returns List and Map interfaces accordingly without any elements. List containers accept also PathEndOfList index, referring to the position of an appended item; values returned for it are used as conversion targets by Append and Insert of containers not managed by this package.
 	GetNew(string) (any, error)
 
 	// Mutable is a mutable variant of Get method - it returns value associated with the given field / index / key. It returns error if the container is read-only or the field / index / key is not found. For scalar types and messages it returns its value. For lists and maps it returns List and Map interfaces accordingly.
@@ -82,9 +82,29 @@ type Container interface {
 	Insert(string, any) error
 }
 
+// WrappingContainer is a container wrapping another container, for example to perform additional actions after modifications of values within the wrapped container. Filters, inverse patches (see ApplyWithInverse) and test operations look through wrapping containers, so that values within them are handled like values within the wrapped container. Rollback (see WithRollback) does not, as wrapped containers may hold copies of values of the patched message.
+type WrappingContainer interface {
+	Container
+
+	// Unwrap returns the wrapped container.
+	Unwrap() Container
+}
+
+// unwrapContainer returns the innermost container wrapped by the given one (see WrappingContainer).
+func unwrapContainer(c Container) Container {
+	for {
+		w, ok := c.(WrappingContainer)
+		if !ok {
+			return c
+		}
+		c = w.Unwrap()
+	}
+}
+
 type messageContainer struct {
-	msg protoreflect.Message
-	ro  bool
+	msg   protoreflect.Message
+	ro    bool
+	guard *fieldGuard
 }
 
 func MessageContainer(m proto.Message) Container {
@@ -127,6 +147,7 @@ type listContainer struct {
 	parentField protoreflect.FieldDescriptor
 	li          protoreflect.List
 	ro          bool
+	guard       *fieldGuard
 }
 
 func newListContainer(m protoreflect.Message, f protoreflect.FieldDescriptor, li protoreflect.List, ro bool) *listContainer {
@@ -163,6 +184,7 @@ type mapContainer struct {
 	parentField protoreflect.FieldDescriptor
 	ma          protoreflect.Map
 	ro          bool
+	guard       *fieldGuard
 }
 
 func newMapContainer(m protoreflect.Message, f protoreflect.FieldDescriptor, ma protoreflect.Map, ro bool) *mapContainer {
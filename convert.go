@@ -1,6 +1,10 @@
 package protopatch
 
-import "reflect"
+import (
+	"reflect"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
 
 // func (v *messageContainer) Convert(to any) (any, error) {
 // 	if reflect.TypeOf(v.msg.Interface()) == reflect.TypeOf(to) {
@@ -75,8 +79,21 @@ import "reflect"
 // 	return nil, errors.New("cannot convert")
 // }
 
-// IdentityConverter converts the provided value to itself ensuring that provided types match. It returns ErrNoConversionDefined error for mismatched types.
+// IdentityConverter converts the provided value to itself ensuring that provided types match. Enum numbers and enum values of the same enum as the to value are converted to protoreflect.EnumNumber numbers, refusing numbers not defined within closed enums with ErrUnknownEnumNumber error. It returns ErrNoConversionDefined error for mismatched types.
 func IdentityConverter(to, from any) (any, error) {
+	// EnumNumber or enum value to enum
+	if toEnum, ok := to.(protoreflect.Enum); ok {
+		switch v := from.(type) {
+		case protoreflect.EnumNumber:
+			return enumNumber(toEnum.Descriptor(), v)
+		case protoreflect.Enum:
+			if v.Descriptor().FullName() == toEnum.Descriptor().FullName() {
+				return enumNumber(toEnum.Descriptor(), v.Number())
+			}
+		}
+		return nil, ErrNoConversionDefined
+	}
+
 	toVal, fromVal := reflect.ValueOf(to), reflect.ValueOf(from)
 
 	// proto.Message, scalars, go types
@@ -116,3 +133,11 @@ func IdentityConverter(to, from any) (any, error) {
 
 	return nil, ErrNoConversionDefined
 }
+
+// enumNumber returns the number as a value of the given enum. Numbers not defined within closed enums cannot be represented and are refused.
+func enumNumber(enum protoreflect.EnumDescriptor, num protoreflect.EnumNumber) (any, error) {
+	if enum.IsClosed() && enum.Values().ByNumber(num) == nil {
+		return nil, ErrUnknownEnumNumber{Enum: string(enum.FullName()), Number: num}
+	}
+	return num, nil
+}
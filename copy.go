@@ -7,6 +7,18 @@ func Copy(base proto.Message, targetPath, replacementPath string, opts ...Option
 }
 
 func copyWithSetup(base proto.Message, targetPath, replacementPath string, setup *setup) error {
+	if err := checkNoWildcard(targetPath, OpCopy); err != nil {
+		return err
+	}
+	if err := checkNoWildcard(replacementPath, OpCopy); err != nil {
+		return err
+	}
+	if err := checkPath(base, targetPath, setup); err != nil {
+		return err
+	}
+	if err := checkPath(base, replacementPath, setup); err != nil {
+		return err
+	}
 	if targetPath == replacementPath { // set value pointed by path to itself
 		return setToItself(base, targetPath, setup)
 	}
@@ -0,0 +1,270 @@
+package protopatch
+
+import (
+	"bytes"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Diff computes a patch that turns the from message into the to message. Applying the returned patch to the from message (with Apply and no conversion options) produces a message equal (in terms of proto.Equal) to the to message. Both messages must be of the same type, otherwise ErrMismatchingType error is returned. A typed nil (invalid) message is treated as an empty one.
+//
+// Paths in the returned patch use JSON names of fields. Unchanged fields produce no operations and nested messages set in both messages are compared recursively. Lists are compared using their longest common subsequence, producing clear and insert operations for specific list indexes (and move operations when WithListMoves option is provided). Map keys are escaped with EscapePathSegment when necessary. Unknown fields are ignored.
+func Diff(from, to proto.Message, opts ...Option) (Patch, error) {
+	fromPr, toPr := from.ProtoReflect(), to.ProtoReflect()
+	if fromPr.Descriptor() != toPr.Descriptor() {
+		return nil, ErrMismatchingType
+	}
+	d := differ{moves: newSetup(opts...).listMoves}
+	d.message("", fromPr, toPr)
+	return d.patch, nil
+}
+
+// WithListMoves returns option that makes Diff emit move operations for list items that changed their position, instead of clearing and inserting them again.
+func WithListMoves() Option {
+	return optionFunc(func(s *setup) {
+		s.listMoves = true
+	})
+}
+
+type differ struct {
+	patch Patch
+	moves bool
+}
+
+func (d *differ) add(op OpKind, path Path, value any) {
+	d.patch = append(d.patch, Operation{Op: op, Path: string(path), Value: value})
+}
+
+func (d *differ) message(path Path, from, to protoreflect.Message) {
+	fields := from.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldPath := path.JoinKeys(field.JSONName())
+		fromHas, toHas := from.Has(field), to.Has(field)
+		switch {
+		case !fromHas && !toHas:
+			continue
+		case !toHas:
+			if oneof := field.ContainingOneof(); oneof != nil && to.WhichOneof(oneof) != nil {
+				continue // setting the other field of the oneof clears this one
+			}
+			d.add(OpClear, fieldPath, nil)
+		case field.IsList():
+			d.list(fieldPath, field, from.Get(field).List(), to.Get(field).List())
+		case field.IsMap():
+			d.mapField(fieldPath, field, from.Get(field).Map(), to.Get(field).Map())
+		case !fromHas:
+			d.add(OpSet, fieldPath, copyOfElement(field.Kind(), to.Get(field)))
+		default:
+			d.element(fieldPath, field.Kind(), from.Get(field), to.Get(field))
+		}
+	}
+}
+
+// list computes an edit script for the given lists based on their longest common subsequence. Items are matched by equality; unmatched items of both lists that lie between the same matched items are paired and compared recursively, the rest is cleared or inserted. With list moves enabled, unmatched items equal to an unmatched item of the other list are moved instead. Operations are generated by simulating their effect on the list, so each one uses indexes valid at the point of its application.
+func (d *differ) list(path Path, field protoreflect.FieldDescriptor, from, to protoreflect.List) {
+	kind := field.Kind()
+	source := matchListItems(kind, from, to, d.moves) // source[j] is the index of from item placed at index j of to list or -1 for new items
+
+	used := make([]bool, from.Len())
+	for _, i := range source {
+		if i >= 0 {
+			used[i] = true
+		}
+	}
+	work := make([]int, 0, max(from.Len(), to.Len())) // indexes of from items in the current state of the list; -1 for inserted items
+	for i := 0; i < from.Len(); i++ {
+		work = append(work, i)
+	}
+	for i := from.Len() - 1; i >= 0; i-- {
+		if !used[i] {
+			d.add(OpClear, path.JoinKeys(strconv.Itoa(i)), nil)
+			work = slices.Delete(work, i, i+1)
+		}
+	}
+
+	for j := 0; j < to.Len(); j++ {
+		i := source[j]
+		if i < 0 {
+			if j == len(work) {
+				d.add(OpAppend, path, copyOfElement(kind, to.Get(j)))
+			} else {
+				d.add(OpInsert, path.JoinKeys(strconv.Itoa(j)), copyOfElement(kind, to.Get(j)))
+			}
+			work = slices.Insert(work, j, -1)
+			continue
+		}
+		if p := slices.Index(work, i); p != j { // move the item in front of its current position; p > j as all preceding items are already in place
+			d.add(OpInsert, path.JoinKeys(strconv.Itoa(j)), copyOfElement(kind, from.NewElement()))
+			d.patch = append(d.patch, Operation{Op: OpMove, Path: string(path.JoinKeys(strconv.Itoa(j))), From: string(path.JoinKeys(strconv.Itoa(p + 1)))})
+			work = slices.Delete(work, p, p+1)
+			work = slices.Insert(work, j, i)
+		}
+		d.element(path.JoinKeys(strconv.Itoa(j)), kind, from.Get(i), to.Get(j))
+	}
+}
+
+// matchListItems returns for each item of the to list the index of the from list item it originates from or -1 if the item is new.
+func matchListItems(kind protoreflect.Kind, from, to protoreflect.List, moves bool) []int {
+	n, m := from.Len(), to.Len()
+	equal := func(i, j int) bool { return equalElements(kind, from.Get(i), to.Get(j)) }
+
+	// lcs[i][j] is the length of the longest common subsequence of from[i:] and to[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if equal(i, j) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	source := make([]int, m)
+	for j := range source {
+		source[j] = -1
+	}
+	type gap struct{ from, to []int } // unmatched items between two consecutive matched ones
+	gaps := []gap{{}}
+	for i, j := 0, 0; i < n || j < m; {
+		switch {
+		case i < n && j < m && equal(i, j) && lcs[i][j] == lcs[i+1][j+1]+1:
+			source[j] = i
+			gaps = append(gaps, gap{})
+			i, j = i+1, j+1
+		case j == m || (i < n && lcs[i+1][j] >= lcs[i][j+1]):
+			gaps[len(gaps)-1].from = append(gaps[len(gaps)-1].from, i)
+			i++
+		default:
+			gaps[len(gaps)-1].to = append(gaps[len(gaps)-1].to, j)
+			j++
+		}
+	}
+
+	moved := make([]bool, n)
+	if moves {
+		for _, g := range gaps {
+			for _, j := range g.to {
+				for _, og := range gaps {
+					if i := slices.IndexFunc(og.from, func(i int) bool { return !moved[i] && equal(i, j) }); i >= 0 {
+						source[j], moved[og.from[i]] = og.from[i], true
+						break
+					}
+				}
+			}
+		}
+	}
+	for _, g := range gaps { // pair remaining unmatched items, so they can be compared recursively
+		fromLeft := slices.DeleteFunc(slices.Clone(g.from), func(i int) bool { return moved[i] })
+		toLeft := slices.DeleteFunc(slices.Clone(g.to), func(j int) bool { return source[j] >= 0 })
+		for k := 0; k < min(len(fromLeft), len(toLeft)); k++ {
+			source[toLeft[k]] = fromLeft[k]
+		}
+	}
+	return source
+}
+
+func (d *differ) mapField(path Path, field protoreflect.FieldDescriptor, from, to protoreflect.Map) {
+	seen := map[any]protoreflect.MapKey{}
+	collect := func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		seen[k.Interface()] = k
+		return true
+	}
+	from.Range(collect)
+	to.Range(collect)
+	keys := make([]protoreflect.MapKey, 0, len(seen))
+	for _, k := range seen {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, compareMapKeys)
+
+	kind := field.MapValue().Kind()
+	for _, k := range keys {
+		keyPath := path.JoinKeys(k.String())
+		switch {
+		case !to.Has(k):
+			d.add(OpClear, keyPath, nil)
+		case !from.Has(k):
+			d.add(OpSet, keyPath, copyOfElement(kind, to.Get(k)))
+		default:
+			d.element(keyPath, kind, from.Get(k), to.Get(k))
+		}
+	}
+}
+
+// element compares list items or map values of the given kind.
+func (d *differ) element(path Path, kind protoreflect.Kind, from, to protoreflect.Value) {
+	if isMessageKind(kind) {
+		d.message(path, from.Message(), to.Message())
+		return
+	}
+	if !equalScalars(from, to) {
+		d.add(OpSet, path, copyOfElement(kind, to))
+	}
+}
+
+func isMessageKind(kind protoreflect.Kind) bool {
+	return kind == protoreflect.MessageKind || kind == protoreflect.GroupKind
+}
+
+func equalElements(kind protoreflect.Kind, x, y protoreflect.Value) bool {
+	if isMessageKind(kind) {
+		return proto.Equal(x.Message().Interface(), y.Message().Interface())
+	}
+	return equalScalars(x, y)
+}
+
+func equalScalars(x, y protoreflect.Value) bool {
+	if xb, ok := x.Interface().([]byte); ok {
+		return bytes.Equal(xb, y.Bytes())
+	}
+	return x.Equal(y)
+}
+
+func compareMapKeys(x, y protoreflect.MapKey) int {
+	switch xv := x.Interface().(type) {
+	case bool:
+		return compareOrdered(boolToInt(xv), boolToInt(y.Bool()))
+	case int32, int64:
+		return compareOrdered(x.Int(), y.Int())
+	case uint32, uint64:
+		return compareOrdered(x.Uint(), y.Uint())
+	}
+	return strings.Compare(x.String(), y.String())
+}
+
+func compareOrdered[T int | int64 | uint64](x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// copyOfElement returns a deep copy of the given singular value, list item or map value in a form accepted by set operation.
+func copyOfElement(kind protoreflect.Kind, v protoreflect.Value) any {
+	if isMessageKind(kind) {
+		return proto.Clone(v.Message().Interface())
+	}
+	if b, ok := v.Interface().([]byte); ok {
+		return bytes.Clone(b)
+	}
+	return v.Interface()
+}
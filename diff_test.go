@@ -0,0 +1,226 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		from proto.Message
+		to   proto.Message
+		opts []protopatch.Option
+		want protopatch.Patch // nil means that only round trip is checked
+	}{
+		{
+			name: "equal",
+			from: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{Int32: 1}},
+			to:   &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{Int32: 1}},
+			want: protopatch.Patch{},
+		},
+		{
+			name: "scalars",
+			from: &protopatchv1.TestMessage{String_: "aaa", Int32: 1, Bytes: []byte("a")},
+			to:   &protopatchv1.TestMessage{String_: "bbb", Int64: 2, Bytes: []byte("a"), Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "int32"},
+				{Op: protopatch.OpSet, Path: "int64", Value: int64(2)},
+				{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+				{Op: protopatch.OpSet, Path: "enum", Value: protopatchv1.Enum_ENUM_VALUE_OTHER.Number()},
+			},
+		},
+		{
+			name: "nested-message",
+			from: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{Int32: 1}}},
+			to:   &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "bbb"}, WellKnown: &protopatchv1.TestWellKnown{}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "message.string", Value: "bbb"},
+				{Op: protopatch.OpClear, Path: "message.message"},
+				{Op: protopatch.OpSet, Path: "wellKnown", Value: &protopatchv1.TestWellKnown{}},
+			},
+		},
+		{
+			name: "oneof",
+			from: &protopatchv1.TestMessage{Oneof: &protopatchv1.TestOneof{Types: &protopatchv1.TestOneof_Bool{Bool: true}}},
+			to:   &protopatchv1.TestMessage{Oneof: &protopatchv1.TestOneof{Types: &protopatchv1.TestOneof_Int32{Int32: 1}}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "oneof.int32", Value: int32(1)},
+			},
+		},
+		{
+			name: "list",
+			from: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "b"},
+				Int32:   []int32{1, 2, 3},
+				Message: []*protopatchv1.TestMessage{{String_: "a"}},
+			}},
+			to: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "c", "d"},
+				Int32:   []int32{1},
+				Message: []*protopatchv1.TestMessage{{String_: "b"}},
+			}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.int32.2"},
+				{Op: protopatch.OpClear, Path: "list.int32.1"},
+				{Op: protopatch.OpSet, Path: "list.string.1", Value: "c"},
+				{Op: protopatch.OpAppend, Path: "list.string", Value: "d"},
+				{Op: protopatch.OpSet, Path: "list.message.0.string", Value: "b"},
+			},
+		},
+		{
+			name: "list/insert-and-clear-in-the-middle",
+			from: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c", "d", "e"}}},
+			to:   &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"x", "a", "c", "y", "d", "e", "z"}}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.string.1"},
+				{Op: protopatch.OpInsert, Path: "list.string.0", Value: "x"},
+				{Op: protopatch.OpInsert, Path: "list.string.3", Value: "y"},
+				{Op: protopatch.OpAppend, Path: "list.string", Value: "z"},
+			},
+		},
+		{
+			name: "list/changed-messages",
+			from: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b"}, {String_: "c"}}}},
+			to:   &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b", Int32: 1}, {String_: "c"}}}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message.1.int32", Value: int32(1)},
+			},
+		},
+		{
+			name: "list/reordered",
+			from: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c", "d"}}},
+			to:   &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"d", "a", "b", "c"}}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.string.3"},
+				{Op: protopatch.OpInsert, Path: "list.string.0", Value: "d"},
+			},
+		},
+		{
+			name: "list/reordered-with-moves",
+			from: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c", "d"}}},
+			to:   &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"d", "a", "b", "c"}}},
+			opts: []protopatch.Option{protopatch.WithListMoves()},
+			want: protopatch.Patch{
+				{Op: protopatch.OpInsert, Path: "list.string.0", Value: ""},
+				{Op: protopatch.OpMove, Path: "list.string.0", From: "list.string.4"},
+			},
+		},
+		{
+			name: "list/shuffled-with-moves",
+			from: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{
+				{String_: "a"}, {String_: "b"}, {String_: "c"}, {String_: "d"}, {String_: "e"}, {String_: "f"},
+			}}},
+			to: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{
+				{String_: "f"}, {String_: "c"}, {String_: "x"}, {String_: "a"}, {String_: "e", Int32: 1}, {String_: "b"},
+			}}},
+			opts: []protopatch.Option{protopatch.WithListMoves()},
+		},
+		{
+			name: "list/shuffled",
+			from: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{
+				{String_: "a"}, {String_: "b"}, {String_: "c"}, {String_: "d"}, {String_: "e"}, {String_: "f"},
+			}}},
+			to: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{
+				{String_: "f"}, {String_: "c"}, {String_: "x"}, {String_: "a"}, {String_: "e", Int32: 1}, {String_: "b"},
+			}}},
+		},
+		{
+			name: "map",
+			from: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{
+				Int32ToString:   map[int32]string{1: "a", 2: "b", 10: "c"},
+				StringToMessage: map[string]*protopatchv1.TestMessage{"a": {String_: "a"}},
+			}},
+			to: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{
+				Int32ToString:   map[int32]string{2: "b", 10: "d", 11: "e"},
+				StringToMessage: map[string]*protopatchv1.TestMessage{"a": {String_: "b"}, "b": {}},
+			}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "map.int32ToString.1"},
+				{Op: protopatch.OpSet, Path: "map.int32ToString.10", Value: "d"},
+				{Op: protopatch.OpSet, Path: "map.int32ToString.11", Value: "e"},
+				{Op: protopatch.OpSet, Path: "map.stringToMessage.a.string", Value: "b"},
+				{Op: protopatch.OpSet, Path: "map.stringToMessage.b", Value: &protopatchv1.TestMessage{}},
+			},
+		},
+		{
+			name: "map-key-with-separator",
+			from: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a": "a", "": "c"}}},
+			to:   &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a": "a", "b.c": "b"}}},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: `map.stringToString.[""]`},
+				{Op: protopatch.OpSet, Path: `map.stringToString.b\.c`, Value: "b"},
+			},
+		},
+		{
+			name: "well-known",
+			from: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("a")}}),
+			}},
+			to: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNumberValue(1), "b": structpb.NewNullValue()}}),
+			}},
+		},
+		{
+			name: "from-empty",
+			from: &protopatchv1.TestMessage{},
+			to: &protopatchv1.TestMessage{
+				String_: "aaa",
+				Message: &protopatchv1.TestMessage{Int32: 1},
+				List:    &protopatchv1.TestList{String_: []string{"a"}},
+				Map:     &protopatchv1.TestMap{BoolToString: map[bool]string{true: "a"}},
+			},
+		},
+		{
+			name: "to-empty",
+			from: &protopatchv1.TestMessage{
+				String_: "aaa",
+				Message: &protopatchv1.TestMessage{Int32: 1},
+				List:    &protopatchv1.TestList{String_: []string{"a"}},
+				Map:     &protopatchv1.TestMap{BoolToString: map[bool]string{true: "a"}},
+			},
+			to: &protopatchv1.TestMessage{},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "string"},
+				{Op: protopatch.OpClear, Path: "message"},
+				{Op: protopatch.OpClear, Path: "list"},
+				{Op: protopatch.OpClear, Path: "map"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			patch, err := protopatch.Diff(test.from, test.to, test.opts...)
+			require.NoError(t, err)
+			if test.want != nil {
+				require.Equal(t, len(test.want), len(patch), "patch length mismatch: %v", patch)
+				for i := range test.want {
+					require.Equal(t, test.want[i].Op, patch[i].Op, "operation %d kind mismatch", i)
+					require.Equal(t, test.want[i].Path, patch[i].Path, "operation %d path mismatch", i)
+					patchtest.RequireEqual(t, test.want[i].Value, patch[i].Value, "operation %d value mismatch", i)
+				}
+			}
+
+			got := proto.Clone(test.from)
+			require.NoError(t, protopatch.Apply(got, patch))
+			patchtest.RequireEqual(t, test.to, got, "message after applying diff mismatch")
+		})
+	}
+}
+
+func TestDiffMismatchingTypes(t *testing.T) {
+	t.Parallel()
+	_, err := protopatch.Diff(&protopatchv1.TestMessage{}, &protopatchv1.TestList{})
+	require.ErrorIs(t, err, protopatch.ErrMismatchingType)
+}
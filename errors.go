@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"github.com/daishe/protopatch/internal/protoops"
 )
 
@@ -14,6 +16,10 @@ var (
 	// ErrDeleteNonKey            = errors.New("connot delete non key value")
 	ErrMutationOfReadOnlyValue = errors.New("connot mutate read-only value")
 	ErrMismatchingType         = protoops.ErrMismatchingType
+	ErrUnknownOperation        = errors.New("unknown patch operation")
+	ErrInvalidJSONPointer      = errors.New("invalid JSON pointer")
+	ErrWildcardInNonCollection = errors.New("cannot expand wildcard; elements of an entity that is not a list or map cannot be enumerated")
+	ErrInvalidFieldMask        = errors.New("invalid field mask path; only singular message fields can be descended into")
 )
 
 type ErrNotFound struct {
@@ -28,6 +34,63 @@ func (e ErrNotFound) Error() string {
 	return fmt.Sprintf("%s %q not found", e.Kind, e.Value)
 }
 
+// ErrUnknownEnumNumber is returned when a number that is not defined within an enum is converted to a value of that enum, while the enum is closed or converter options require numbers to be defined.
+type ErrUnknownEnumNumber struct {
+	Enum   string // full name of the enum
+	Number protoreflect.EnumNumber
+}
+
+func (e ErrUnknownEnumNumber) Error() string {
+	return fmt.Sprintf("number %d is not defined within enum %q", e.Number, e.Enum)
+}
+
+// ErrWildcardNotAllowed is returned when a path of an operation addressing a single element (move, copy, swap, insert or test operation) contains a wildcard segment. Map keys equal to the wildcard must be escaped (see EscapePathSegment).
+type ErrWildcardNotAllowed struct {
+	Op OpKind
+}
+
+func (e ErrWildcardNotAllowed) Error() string {
+	return fmt.Sprintf("wildcard path segments are not allowed in %s operation paths", e.Op)
+}
+
+// ErrInvalidFilter is returned when a filter path segment cannot be parsed.
+type ErrInvalidFilter struct {
+	Filter string
+}
+
+func (e ErrInvalidFilter) Error() string {
+	return fmt.Sprintf("invalid filter %q", e.Filter)
+}
+
+// ErrAmbiguousFilter is returned when a filter path segment that must select a single list item matches several items.
+type ErrAmbiguousFilter struct {
+	Filter  string
+	Matches int
+}
+
+func (e ErrAmbiguousFilter) Error() string {
+	return fmt.Sprintf("filter %q is ambiguous; it matches %d items", e.Filter, e.Matches)
+}
+
+// ErrPathForbidden is returned when a path policy does not allow an operation to access the value pointed by a path (see WithPathPolicy). Path is the normalized path passed to the policy.
+type ErrPathForbidden struct {
+	Path string
+}
+
+func (e ErrPathForbidden) Error() string {
+	return fmt.Sprintf("access to path %q is forbidden", e.Path)
+}
+
+// ErrProtectedField is returned when an operation attempts to modify a field marked as immutable or output only (see WithFieldBehavior).
+type ErrProtectedField struct {
+	Field    string // full name of the field
+	Behavior string // "immutable" or "output only"
+}
+
+func (e ErrProtectedField) Error() string {
+	return fmt.Sprintf("cannot modify %s field %q", e.Behavior, e.Field)
+}
+
 type ErrOperationFailed struct {
 	Op    string
 	Cause error
@@ -91,3 +154,38 @@ func (e ErrInPath) Error() string {
 func (e ErrInPath) Unwrap() error {
 	return e.Cause
 }
+
+// ErrTestFailed is returned by test operation when the tested value differs from the expected one. Expected holds the expected value after conversion to the type of the tested value.
+type ErrTestFailed struct {
+	Expected any
+	Actual   any
+}
+
+func (e ErrTestFailed) Error() string {
+	if e.Expected == nil {
+		return fmt.Sprintf("test failed: expected unpopulated value, got %v", e.Actual)
+	}
+	return fmt.Sprintf("test failed: expected %v, got %v", e.Expected, e.Actual)
+}
+
+// ErrInOperation describes failure of a single operation within a patch. Cause is an ErrInPath error for all failures related to the operation paths.
+type ErrInOperation struct {
+	Index int
+	Op    OpKind
+	Cause error
+}
+
+func newErrInOperation(index int, op Operation, err error) error {
+	if _, ok := err.(ErrInPath); !ok && err != ErrUnknownOperation {
+		err = ErrInPath{Path: op.Path, Cause: err}
+	}
+	return ErrInOperation{Index: index, Op: op.Op, Cause: err}
+}
+
+func (e ErrInOperation) Error() string {
+	return fmt.Sprintf("patch operation %d (%s): %s", e.Index, e.Op, e.Cause.Error())
+}
+
+func (e ErrInOperation) Unwrap() error {
+	return e.Cause
+}
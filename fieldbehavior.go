@@ -0,0 +1,202 @@
+package protopatch
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	protopatchv1 "github.com/daishe/protopatch/types/protopatch/v1"
+)
+
+// WithFieldBehavior returns option that makes all operations refuse to modify fields marked as immutable or output only, either with protopatch.v1.field option (for example [(protopatch.v1.field).immutable = true]) or with google.api.field_behavior option (IMMUTABLE and OUTPUT_ONLY behaviors). Such operations fail with ErrProtectedField error.
+//
+// Values within protected fields (for example fields of protected messages or items of protected lists) cannot be modified either. Replacing or clearing a message containing protected fields (including the base message) is allowed only as long as values of all protected fields within it, and within its nested messages, remain the same. List items and map values are treated as a whole, so they may be added, removed or replaced regardless of protected fields within them.
+func WithFieldBehavior() Option {
+	return optionFunc(func(s *setup) {
+		s.fieldBehavior = true
+	})
+}
+
+const (
+	behaviorImmutable  = "immutable"
+	behaviorOutputOnly = "output only"
+)
+
+// google.api.field_behavior field option and its values (see google/api/field_behavior.proto). The option is read from encoded field options, so that support for it does not require dependency on generated googleapis types.
+const (
+	apiFieldBehaviorNumber     protowire.Number = 1052
+	apiFieldBehaviorOutputOnly                  = 3
+	apiFieldBehaviorImmutable                   = 5
+)
+
+// fieldBehavior returns behavior of the given field ("immutable" or "output only") or an empty string for fields that can be freely modified.
+func fieldBehavior(field protoreflect.FieldDescriptor) string {
+	opts := field.Options()
+	if o, ok := proto.GetExtension(opts, protopatchv1.E_Field).(*protopatchv1.FieldOptions); ok {
+		switch {
+		case o.GetOutputOnly():
+			return behaviorOutputOnly
+		case o.GetImmutable():
+			return behaviorImmutable
+		}
+	}
+	return apiFieldBehavior(opts)
+}
+
+func apiFieldBehavior(opts proto.Message) string {
+	raw, err := proto.Marshal(opts)
+	if err != nil {
+		return ""
+	}
+	behavior := func(v uint64) string {
+		switch v {
+		case apiFieldBehaviorOutputOnly:
+			return behaviorOutputOnly
+		case apiFieldBehaviorImmutable:
+			return behaviorImmutable
+		}
+		return ""
+	}
+	for len(raw) > 0 {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n < 0 {
+			return ""
+		}
+		raw = raw[n:]
+		switch {
+		case num == apiFieldBehaviorNumber && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(raw)
+			if n < 0 {
+				return ""
+			}
+			if b := behavior(v); b != "" {
+				return b
+			}
+		case num == apiFieldBehaviorNumber && typ == protowire.BytesType: // packed encoding
+			packed, n := protowire.ConsumeBytes(raw)
+			if n < 0 {
+				return ""
+			}
+			for len(packed) > 0 {
+				v, m := protowire.ConsumeVarint(packed)
+				if m < 0 {
+					return ""
+				}
+				if b := behavior(v); b != "" {
+					return b
+				}
+				packed = packed[m:]
+			}
+		}
+		n = protowire.ConsumeFieldValue(num, typ, raw)
+		if n < 0 {
+			return ""
+		}
+		raw = raw[n:]
+	}
+	return ""
+}
+
+func newErrProtectedField(field protoreflect.FieldDescriptor) error {
+	return ErrProtectedField{Field: string(field.FullName()), Behavior: fieldBehavior(field)}
+}
+
+// fieldGuard refuses modifications of protected fields. A nil guard allows all modifications.
+type fieldGuard struct {
+	frozen error // error returned for all modifications of containers holding values of protected fields
+}
+
+// guardContainer enables protection of fields for the given freshly accessed container, if requested by the setup.
+func guardContainer(c Container, setup *setup) {
+	if !setup.fieldBehavior {
+		return
+	}
+	switch c := c.(type) {
+	case *messageContainer:
+		if c.guard == nil {
+			c.guard = &fieldGuard{}
+		}
+	case *listContainer:
+		if c.guard == nil {
+			c.guard = &fieldGuard{}
+		}
+	case *mapContainer:
+		if c.guard == nil {
+			c.guard = &fieldGuard{}
+		}
+	}
+}
+
+// enter returns guard of the container holding value of the given field.
+func (g *fieldGuard) enter(field protoreflect.FieldDescriptor) *fieldGuard {
+	if g == nil || g.frozen != nil || fieldBehavior(field) == "" {
+		return g
+	}
+	return &fieldGuard{frozen: newErrProtectedField(field)}
+}
+
+// mutable returns error if the guarded container cannot be modified.
+func (g *fieldGuard) mutable() error {
+	if g == nil {
+		return nil
+	}
+	return g.frozen
+}
+
+// field returns error if the given field of the guarded message cannot be modified.
+func (g *fieldGuard) field(field protoreflect.FieldDescriptor) error {
+	if err := g.mutable(); err != nil || g == nil {
+		return err
+	}
+	if fieldBehavior(field) != "" {
+		return newErrProtectedField(field)
+	}
+	return nil
+}
+
+// oneof returns error if populating the given field of the guarded message clears other field of the same oneof that is protected or contains protected fields.
+func (g *fieldGuard) oneof(msg protoreflect.Message, field protoreflect.FieldDescriptor) error {
+	if g == nil {
+		return nil
+	}
+	od := field.ContainingOneof()
+	if od == nil || od.IsSynthetic() {
+		return nil
+	}
+	other := msg.WhichOneof(od)
+	if other == nil || other == field {
+		return nil
+	}
+	if fieldBehavior(other) != "" {
+		return newErrProtectedField(other)
+	}
+	if other.Kind() == protoreflect.MessageKind {
+		return g.replace(msg.Get(other).Message(), msg.Get(other).Message().Type().Zero())
+	}
+	return nil
+}
+
+// replace returns error if replacing the from message with the to message changes any protected field within them. An invalid to message is treated as an empty one.
+func (g *fieldGuard) replace(from, to protoreflect.Message) error {
+	if err := g.mutable(); err != nil || g == nil {
+		return err
+	}
+	fields := from.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fromHas, toHas := from.Has(field), to.IsValid() && to.Has(field)
+		switch {
+		case !fromHas && !toHas:
+			continue
+		case fieldBehavior(field) != "":
+			if fromHas != toHas || !from.Get(field).Equal(to.Get(field)) {
+				return newErrProtectedField(field)
+			}
+		case field.Kind() == protoreflect.MessageKind && !field.IsList() && !field.IsMap():
+			if err := g.replace(from.Get(field).Message(), to.Get(field).Message()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestWithFieldBehavior(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestFieldBehavior{
+		Id:               "id",
+		Name:             "name",
+		State:            "state",
+		CreateTime:       "time",
+		Owner:            "owner",
+		ImmutableMessage: &protopatchv1.TestFieldBehavior{Name: "name"},
+		ImmutableList:    []string{"a"},
+		ImmutableMap:     map[string]string{"a": "a"},
+		Message:          &protopatchv1.TestFieldBehavior{Id: "id"},
+		List:             []*protopatchv1.TestFieldBehavior{{Id: "id"}},
+		Map:              map[string]*protopatchv1.TestFieldBehavior{"a": {Id: "id"}},
+	}
+	protected := func(path, field, behavior string) error {
+		return protopatch.NewErrInPath(path, protopatch.ErrProtectedField{Field: "protopatch.v1.TestFieldBehavior." + field, Behavior: behavior})
+	}
+
+	tests := []struct {
+		name    string
+		op      protopatch.Operation
+		wantErr error
+	}{
+		{name: "set-unprotected", op: protopatch.Operation{Op: protopatch.OpSet, Path: "name", Value: "x"}},
+		{name: "set-immutable", op: protopatch.Operation{Op: protopatch.OpSet, Path: "id", Value: "x"}, wantErr: protected("id", "id", "immutable")},
+		{name: "set-output-only", op: protopatch.Operation{Op: protopatch.OpSet, Path: "state", Value: "x"}, wantErr: protected("state", "state", "output only")},
+		{name: "set-api-output-only", op: protopatch.Operation{Op: protopatch.OpSet, Path: "createTime", Value: "x"}, wantErr: protected("createTime", "create_time", "output only")},
+		{name: "set-api-immutable", op: protopatch.Operation{Op: protopatch.OpSet, Path: "owner", Value: "x"}, wantErr: protected("owner", "owner", "immutable")},
+		{name: "clear-immutable", op: protopatch.Operation{Op: protopatch.OpClear, Path: "id"}, wantErr: protected("id", "id", "immutable")},
+		{name: "move-from-immutable", op: protopatch.Operation{Op: protopatch.OpMove, Path: "name", From: "id"}, wantErr: protected("name", "id", "immutable")},
+		{name: "copy-from-immutable", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "name", From: "id"}},
+		{name: "swap-with-immutable", op: protopatch.Operation{Op: protopatch.OpSwap, Path: "name", From: "id"}, wantErr: protected("name", "id", "immutable")},
+		{name: "set-within-immutable-message", op: protopatch.Operation{Op: protopatch.OpSet, Path: "immutableMessage.name", Value: "x"}, wantErr: protected("immutableMessage", "immutable_message", "immutable")},
+		{name: "append-to-immutable-list", op: protopatch.Operation{Op: protopatch.OpAppend, Path: "immutableList", Value: "x"}, wantErr: protected("immutableList", "immutable_list", "immutable")},
+		{name: "insert-to-immutable-list", op: protopatch.Operation{Op: protopatch.OpInsert, Path: "immutableList.0", Value: "x"}, wantErr: protected("immutableList", "immutable_list", "immutable")},
+		{name: "set-immutable-list-item", op: protopatch.Operation{Op: protopatch.OpSet, Path: "immutableList.*", Value: "x"}, wantErr: protected("immutableList", "immutable_list", "immutable")},
+		{name: "set-immutable-map-value", op: protopatch.Operation{Op: protopatch.OpSet, Path: "immutableMap.b", Value: "x"}, wantErr: protected("immutableMap", "immutable_map", "immutable")},
+		{name: "set-nested-immutable", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.id", Value: "x"}, wantErr: protected("message", "id", "immutable")},
+		{name: "set-nested-unprotected", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.name", Value: "x"}},
+		{name: "set-message-keeping-immutable", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message", Value: &protopatchv1.TestFieldBehavior{Id: "id", Name: "x"}}},
+		{name: "set-message-changing-immutable", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message", Value: &protopatchv1.TestFieldBehavior{Name: "x"}}, wantErr: protected("message", "id", "immutable")},
+		{name: "clear-message-with-immutable", op: protopatch.Operation{Op: protopatch.OpClear, Path: "message"}, wantErr: protected("message", "id", "immutable")},
+		{name: "set-base-keeping-protected", op: protopatch.Operation{Op: protopatch.OpSet, Path: "", Value: func() proto.Message {
+			m := proto.Clone(base).(*protopatchv1.TestFieldBehavior)
+			m.Name = "x"
+			return m
+		}()}},
+		{name: "set-base-changing-protected", op: protopatch.Operation{Op: protopatch.OpSet, Path: "", Value: &protopatchv1.TestFieldBehavior{Id: "id"}}, wantErr: protected("", "state", "output only")},
+		{name: "clear-base", op: protopatch.Operation{Op: protopatch.OpClear, Path: ""}, wantErr: protected("", "id", "immutable")},
+		{name: "set-list-item-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.0.id", Value: "x"}, wantErr: protected("list.0", "id", "immutable")},
+		{name: "set-map-value-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "map.a.id", Value: "x"}, wantErr: protected("map.a", "id", "immutable")},
+		{name: "replace-list-item", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.0", Value: &protopatchv1.TestFieldBehavior{Id: "x"}}},
+		{name: "clear-map-value", op: protopatch.Operation{Op: protopatch.OpClear, Path: "map.a"}},
+		{name: "test-immutable", op: protopatch.Operation{Op: protopatch.OpTest, Path: "id", Value: "id"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(base)
+			err := protopatch.Apply(msg, protopatch.Patch{test.op}, protopatch.WithFieldBehavior())
+			if test.wantErr != nil {
+				require.Equal(t, protopatch.ErrInOperation{Index: 0, Op: test.op.Op, Cause: test.wantErr}, err)
+				patchtest.RequireEqual(t, base, msg, "message modified by refused operation")
+				return
+			}
+			require.NoError(t, err)
+
+			// without the option every operation succeeds
+			require.NoError(t, protopatch.Apply(proto.Clone(base), protopatch.Patch{test.op}))
+		})
+	}
+}
+
+func TestWithFieldBehaviorCompiledPath(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestFieldBehavior{Id: "id"}
+	cp := protopatch.MustCompile(msg.ProtoReflect().Descriptor(), "id")
+	err := cp.Set(msg, "x", protopatch.WithFieldBehavior())
+	require.Equal(t, protopatch.ErrProtectedField{Field: "protopatch.v1.TestFieldBehavior.id", Behavior: "immutable"}, err)
+	require.NoError(t, cp.Set(msg, "x"))
+	require.Equal(t, "x", msg.Id)
+}
+
+func TestWithFieldBehaviorOneof(t *testing.T) {
+	t.Parallel()
+
+	protected := func(path, field, behavior string) error {
+		return protopatch.NewErrInPath(path, protopatch.ErrProtectedField{Field: "protopatch.v1.TestFieldBehavior." + field, Behavior: behavior})
+	}
+
+	tests := []struct {
+		name    string
+		base    *protopatchv1.TestFieldBehavior
+		op      protopatch.Operation
+		wantErr error
+	}{
+		{
+			name:    "set-sibling-of-immutable",
+			base:    &protopatchv1.TestFieldBehavior{Choice: &protopatchv1.TestFieldBehavior_ImmutableChoice{ImmutableChoice: "a"}},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "freeChoice", Value: "x"},
+			wantErr: protected("freeChoice", "immutable_choice", "immutable"),
+		},
+		{
+			name:    "set-message-sibling-of-immutable",
+			base:    &protopatchv1.TestFieldBehavior{Choice: &protopatchv1.TestFieldBehavior_ImmutableChoice{ImmutableChoice: "a"}},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "messageChoice", Value: &protopatchv1.TestFieldBehavior{}},
+			wantErr: protected("messageChoice", "immutable_choice", "immutable"),
+		},
+		{
+			name:    "set-sibling-of-message-with-immutable",
+			base:    &protopatchv1.TestFieldBehavior{Choice: &protopatchv1.TestFieldBehavior_MessageChoice{MessageChoice: &protopatchv1.TestFieldBehavior{Id: "id"}}},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "freeChoice", Value: "x"},
+			wantErr: protected("freeChoice", "id", "immutable"),
+		},
+		{
+			name: "set-sibling-of-message-without-immutable",
+			base: &protopatchv1.TestFieldBehavior{Choice: &protopatchv1.TestFieldBehavior_MessageChoice{MessageChoice: &protopatchv1.TestFieldBehavior{Name: "name"}}},
+			op:   protopatch.Operation{Op: protopatch.OpSet, Path: "freeChoice", Value: "x"},
+		},
+		{
+			name:    "set-immutable-sibling",
+			base:    &protopatchv1.TestFieldBehavior{Choice: &protopatchv1.TestFieldBehavior_FreeChoice{FreeChoice: "a"}},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "immutableChoice", Value: "x"},
+			wantErr: protected("immutableChoice", "immutable_choice", "immutable"),
+		},
+		{
+			name: "set-populated-member",
+			base: &protopatchv1.TestFieldBehavior{Choice: &protopatchv1.TestFieldBehavior_FreeChoice{FreeChoice: "a"}},
+			op:   protopatch.Operation{Op: protopatch.OpSet, Path: "freeChoice", Value: "x"},
+		},
+		{
+			name: "clear-sibling-of-immutable",
+			base: &protopatchv1.TestFieldBehavior{Choice: &protopatchv1.TestFieldBehavior_ImmutableChoice{ImmutableChoice: "a"}},
+			op:   protopatch.Operation{Op: protopatch.OpClear, Path: "freeChoice"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(test.base)
+			err := protopatch.Apply(msg, protopatch.Patch{test.op}, protopatch.WithFieldBehavior())
+			if test.wantErr != nil {
+				require.Equal(t, protopatch.ErrInOperation{Index: 0, Op: test.op.Op, Cause: test.wantErr}, err)
+				patchtest.RequireEqual(t, test.base, msg, "message modified by refused operation")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
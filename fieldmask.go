@@ -0,0 +1,171 @@
+package protopatch
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FieldMaskWildcard is a field mask path referring to the whole message, requesting its full replacement (as described in AIP-134).
+const FieldMaskWildcard = "*"
+
+// ApplyFieldMask copies fields pointed by the paths of the field mask from the src message into the dst message. Each path is copied as with a set operation, so a field that is not populated in the src message is cleared in the dst message. Unset messages containing the copied fields are created in the dst message when needed. Both messages must be of the same type, otherwise ErrMismatchingType error is returned.
+//
+// Field mask paths may use proto names, JSON names or field numbers. Only singular message fields can be descended into, so lists and maps are always copied as a whole. The FieldMaskWildcard path replaces the whole dst message. Paths are copied in the order they are provided and options are handled as in Apply (in particular WithRollback makes the whole copy atomic).
+func ApplyFieldMask(dst, src proto.Message, mask *fieldmaskpb.FieldMask, opts ...Option) error {
+	srcPr := src.ProtoReflect()
+	if dst.ProtoReflect().Descriptor() != srcPr.Descriptor() {
+		return ErrMismatchingType
+	}
+	patch, err := fieldMaskPatch(dst.ProtoReflect(), srcPr, mask.GetPaths())
+	if err != nil {
+		return err
+	}
+	return applyWithSetup(dst, patch, newSetup(opts...), nil)
+}
+
+// fieldMaskPatch returns operations copying values pointed by the field mask paths from the src message into the dst message. Operations are generated for the dst message in its current state, taking into account changes made by operations generated for preceding paths.
+func fieldMaskPatch(dst, src protoreflect.Message, paths []string) (Patch, error) {
+	patch := Patch(nil)
+	copied, created := map[Path]bool{}, map[Path]bool{} // paths set from the src message and messages created by preceding operations
+	for _, path := range paths {
+		if path == FieldMaskWildcard {
+			patch = append(patch, Operation{Op: OpSet, Path: "", Value: proto.Clone(src.Interface())})
+			copied[""] = true
+			continue
+		}
+		value, err := fieldMaskValue(src, Path(path))
+		if err != nil {
+			return nil, err
+		}
+		if ops, ok := fieldMaskParents(dst, src, Path(path), value != nil, copied, created); ok {
+			patch = append(patch, ops...)
+			patch = append(patch, Operation{Op: OpSet, Path: path, Value: value})
+		}
+		copied[Path(path)] = true
+	}
+	return patch, nil
+}
+
+// fieldMaskParents returns operations creating unset messages containing the field pointed by the field mask path. It reports false if there is nothing to do for the path, as the value is not populated and some of the messages containing it is unset.
+func fieldMaskParents(dst, src protoreflect.Message, path Path, populated bool, copied, created map[Path]bool) ([]Operation, bool) {
+	ops := []Operation(nil)
+	mirrored := copied[""] // whether the message is set from the src message by preceding operations
+	for ps := range path.Iter {
+		if ps.IsLast() {
+			break
+		}
+		field, _ := fieldMaskField(src.Descriptor(), ps) // already validated with the src message
+		p := ps.PrecedingPathWithCurrentSegment()
+		mirrored = mirrored || copied[p]
+		switch {
+		case mirrored:
+			if !src.Has(field) {
+				return nil, false // nothing to clear
+			}
+		case created[p], dst != nil && dst.Has(field):
+		default:
+			if !populated {
+				return nil, false // nothing to clear
+			}
+			ops = append(ops, Operation{Op: OpSet, Path: string(p), Value: src.NewField(field).Message().Interface()})
+			created[p] = true
+		}
+		if dst != nil && dst.Has(field) {
+			dst = dst.Get(field).Message()
+		} else {
+			dst = nil
+		}
+		src = src.Get(field).Message()
+	}
+	return ops, true
+}
+
+// fieldMaskValue returns a copy of the value pointed by the field mask path, or nil if the field (or any message containing it) is not populated.
+func fieldMaskValue(msg protoreflect.Message, path Path) (any, error) {
+	if path == "" {
+		return nil, ErrInvalidFieldMask
+	}
+	populated := true
+	for ps := range path.Iter {
+		field, err := fieldMaskField(msg.Descriptor(), ps)
+		if err != nil {
+			return nil, err
+		}
+		if ps.IsLast() {
+			if !populated || (field.HasPresence() && !msg.Has(field)) {
+				return nil, nil
+			}
+			return MessageContainer(msg.Interface()).GetCopy(ps.Value())
+		}
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			return nil, NewErrInPath(string(ps.PrecedingPathWithCurrentSegment()), ErrInvalidFieldMask)
+		}
+		populated = populated && msg.Has(field)
+		msg = msg.Get(field).Message()
+	}
+	return nil, nil
+}
+
+// fieldMaskField returns descriptor of the field pointed by the given path segment.
+func fieldMaskField(md protoreflect.MessageDescriptor, ps PathSegment) (protoreflect.FieldDescriptor, error) {
+	field, err := fieldInMessage(md.Fields(), ps.Value())
+	if err != nil {
+		if !ps.IsFirst() {
+			return nil, NewErrInPath(string(ps.PrecedingPath()), err)
+		}
+		return nil, err
+	}
+	return field, nil
+}
+
+// FieldMaskFromPatch returns field mask covering all fields modified by the patch, when applied to a message of the same type as the provided one (only the message type is used). Field mask paths use proto names of fields and are normalized (sorted, with paths covered by other paths removed).
+//
+// As field masks cannot refer to list items and map values, paths descending into lists and maps are truncated to the list or map field itself. Operations modifying the whole message produce the FieldMaskWildcard path. Test operations do not modify messages and produce no paths.
+func FieldMaskFromPatch(base proto.Message, patch Patch) (*fieldmaskpb.FieldMask, error) {
+	md := base.ProtoReflect().Descriptor()
+	mask := &fieldmaskpb.FieldMask{}
+	for i, op := range patch {
+		paths := []string(nil)
+		switch op.Op {
+		case OpSet, OpAppend, OpInsert, OpClear, OpCopy:
+			paths = []string{op.Path}
+		case OpMove, OpSwap:
+			paths = []string{op.Path, op.From}
+		case OpTest:
+		default:
+			return nil, ErrInOperation{Index: i, Op: op.Op, Cause: ErrUnknownOperation}
+		}
+		for _, path := range paths {
+			if path == "" {
+				return &fieldmaskpb.FieldMask{Paths: []string{FieldMaskWildcard}}, nil
+			}
+			maskPath, err := fieldMaskPath(md, Path(path))
+			if err != nil {
+				return nil, ErrInOperation{Index: i, Op: op.Op, Cause: err}
+			}
+			mask.Paths = append(mask.Paths, maskPath)
+		}
+	}
+	mask.Normalize()
+	return mask, nil
+}
+
+// fieldMaskPath returns the longest prefix of the path expressible as a field mask path, with proto names of fields.
+func fieldMaskPath(md protoreflect.MessageDescriptor, path Path) (string, error) {
+	names := []string(nil)
+	for ps := range path.Iter {
+		field, err := fieldMaskField(md, ps)
+		if err != nil {
+			return "", err
+		}
+		names = append(names, string(field.Name()))
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			break
+		}
+		md = field.Message()
+	}
+	return strings.Join(names, PathSegmentSeparator), nil
+}
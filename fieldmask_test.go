@@ -0,0 +1,281 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestApplyFieldMask(t *testing.T) {
+	t.Parallel()
+
+	dst := &protopatchv1.TestMessage{
+		String_: "dst",
+		Int32:   1,
+		Message: &protopatchv1.TestMessage{String_: "dst", Int64: 2},
+		List:    &protopatchv1.TestList{String_: []string{"a", "b"}},
+	}
+	src := &protopatchv1.TestMessage{
+		String_: "src",
+		Message: &protopatchv1.TestMessage{Int64: 3},
+		List:    &protopatchv1.TestList{String_: []string{"c"}},
+		Map:     &protopatchv1.TestMap{StringToString: map[string]string{"a": "a"}},
+	}
+
+	tests := []struct {
+		name    string
+		dst     proto.Message
+		src     proto.Message
+		paths   []string
+		opts    []protopatch.Option
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name:  "empty",
+			dst:   dst,
+			src:   src,
+			paths: []string{},
+			want:  dst,
+		},
+		{
+			name:  "scalar-fields",
+			dst:   dst,
+			src:   src,
+			paths: []string{"string", "int32"},
+			want: &protopatchv1.TestMessage{
+				String_: "src",
+				Message: &protopatchv1.TestMessage{String_: "dst", Int64: 2},
+				List:    &protopatchv1.TestList{String_: []string{"a", "b"}},
+			},
+		},
+		{
+			name:  "nested-field",
+			dst:   dst,
+			src:   src,
+			paths: []string{"message.int64"},
+			want: &protopatchv1.TestMessage{
+				String_: "dst",
+				Int32:   1,
+				Message: &protopatchv1.TestMessage{String_: "dst", Int64: 3},
+				List:    &protopatchv1.TestList{String_: []string{"a", "b"}},
+			},
+		},
+		{
+			name:  "whole-message-field",
+			dst:   dst,
+			src:   src,
+			paths: []string{"message"},
+			want: &protopatchv1.TestMessage{
+				String_: "dst",
+				Int32:   1,
+				Message: &protopatchv1.TestMessage{Int64: 3},
+				List:    &protopatchv1.TestList{String_: []string{"a", "b"}},
+			},
+		},
+		{
+			name:  "list-and-map-with-json-names",
+			dst:   dst,
+			src:   src,
+			paths: []string{"list.string", "map.stringToString"},
+			want: &protopatchv1.TestMessage{
+				String_: "dst",
+				Int32:   1,
+				Message: &protopatchv1.TestMessage{String_: "dst", Int64: 2},
+				List:    &protopatchv1.TestList{String_: []string{"c"}},
+				Map:     &protopatchv1.TestMap{StringToString: map[string]string{"a": "a"}},
+			},
+		},
+		{
+			name:  "unset-in-src-clears",
+			dst:   dst,
+			src:   &protopatchv1.TestMessage{},
+			paths: []string{"message", "list", "message.string"},
+			want: &protopatchv1.TestMessage{
+				String_: "dst",
+				Int32:   1,
+			},
+		},
+		{
+			name:  "missing-parents-shared-by-paths",
+			dst:   &protopatchv1.TestMessage{String_: "dst"},
+			src:   &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "src", Int64: 3}}},
+			paths: []string{"message.message.string", "message.int32", "message.message.int64"},
+			want: &protopatchv1.TestMessage{
+				String_: "dst",
+				Message: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "src", Int64: 3}},
+			},
+		},
+		{
+			name:  "nested-fields-after-whole-message-field",
+			dst:   &protopatchv1.TestMessage{String_: "dst"},
+			src:   &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "src", Int64: 3}},
+			paths: []string{"message", "message.int64", "message.message.string"},
+			want: &protopatchv1.TestMessage{
+				String_: "dst",
+				Message: &protopatchv1.TestMessage{String_: "src", Int64: 3},
+			},
+		},
+		{
+			name:  "field-numbers",
+			dst:   dst,
+			src:   src,
+			paths: []string{"17.7"},
+			want: &protopatchv1.TestMessage{
+				String_: "dst",
+				Int32:   1,
+				Message: &protopatchv1.TestMessage{String_: "dst", Int64: 3},
+				List:    &protopatchv1.TestList{String_: []string{"a", "b"}},
+			},
+		},
+		{
+			name:  "full-replacement",
+			dst:   dst,
+			src:   src,
+			paths: []string{"*"},
+			want:  src,
+		},
+		{
+			name:    "unknown-field",
+			dst:     dst,
+			src:     src,
+			paths:   []string{"message.unknown"},
+			wantErr: protopatch.NewErrInPath("message", protopatch.ErrNotFound{Kind: "field", Value: "unknown"}),
+		},
+		{
+			name:    "descend-into-list",
+			dst:     dst,
+			src:     src,
+			paths:   []string{"list.string.0"},
+			wantErr: protopatch.NewErrInPath("list.string", protopatch.ErrInvalidFieldMask),
+		},
+		{
+			name:    "mismatching-type",
+			dst:     dst,
+			src:     &protopatchv1.TestList{},
+			paths:   []string{"string"},
+			wantErr: protopatch.ErrMismatchingType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := proto.Clone(test.dst)
+			err := protopatch.ApplyFieldMask(got, test.src, &fieldmaskpb.FieldMask{Paths: test.paths}, test.opts...)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				patchtest.RequireEqual(t, test.dst, got, "message after failed apply mismatch")
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, got, "message after apply mismatch")
+		})
+	}
+}
+
+func TestFieldMaskFromPatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		patch   protopatch.Patch
+		want    []string
+		wantErr error
+	}{
+		{
+			name:  "empty",
+			patch: protopatch.Patch{},
+			want:  nil,
+		},
+		{
+			name: "proto-names",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "wellKnown", Value: &protopatchv1.TestWellKnown{}},
+				{Op: protopatch.OpClear, Path: "message.int64"},
+				{Op: protopatch.OpSet, Path: "16", Value: protopatchv1.Enum_ENUM_VALUE_OTHER},
+			},
+			want: []string{"enum", "message.int64", "well_known"},
+		},
+		{
+			name: "lists-and-maps-truncated",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpInsert, Path: "list.string.0", Value: "a"},
+				{Op: protopatch.OpSet, Path: "map.stringToMessage.a\\.b.string", Value: "a"},
+				{Op: protopatch.OpSet, Path: "list.message.*.string", Value: "a"},
+			},
+			want: []string{"list.message", "list.string", "map.string_to_message"},
+		},
+		{
+			name: "covered-paths-removed",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "message.message.string", Value: "a"},
+				{Op: protopatch.OpClear, Path: "message"},
+				{Op: protopatch.OpSet, Path: "message.string", Value: "a"},
+			},
+			want: []string{"message"},
+		},
+		{
+			name: "move-and-swap-sources",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "message.string", From: "string"},
+				{Op: protopatch.OpSwap, Path: "int32", From: "int64"},
+				{Op: protopatch.OpCopy, Path: "bool", From: "message.bool"},
+				{Op: protopatch.OpTest, Path: "double", Value: float64(0)},
+			},
+			want: []string{"bool", "int32", "int64", "message.string", "string"},
+		},
+		{
+			name: "whole-message",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "a"},
+				{Op: protopatch.OpSet, Path: "", Value: &protopatchv1.TestMessage{}},
+			},
+			want: []string{"*"},
+		},
+		{
+			name: "unknown-field",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "a"},
+				{Op: protopatch.OpClear, Path: "message.unknown"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 1, Op: protopatch.OpClear, Cause: protopatch.NewErrInPath("message", protopatch.ErrNotFound{Kind: "field", Value: "unknown"})},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := protopatch.FieldMaskFromPatch(&protopatchv1.TestMessage{}, test.patch)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, got.GetPaths())
+		})
+	}
+}
+
+func TestFieldMaskRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	from := &protopatchv1.TestMessage{String_: "a", Message: &protopatchv1.TestMessage{Int32: 1}, List: &protopatchv1.TestList{Int32: []int32{1, 2}}}
+	to := &protopatchv1.TestMessage{String_: "b", Message: &protopatchv1.TestMessage{Int32: 1, Bool: true}, List: &protopatchv1.TestList{Int32: []int32{2, 3}}}
+
+	patch, err := protopatch.Diff(from, to)
+	require.NoError(t, err)
+	mask, err := protopatch.FieldMaskFromPatch(from, patch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"list.int32", "message.bool", "string"}, mask.GetPaths())
+
+	got := proto.Clone(from)
+	require.NoError(t, protopatch.ApplyFieldMask(got, to, mask))
+	patchtest.RequireEqual(t, to, got, "message after applying field mask mismatch")
+}
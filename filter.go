@@ -0,0 +1,233 @@
+package protopatch
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	pathFilterStart = "["
+	pathFilterEnd   = "]"
+)
+
+// PathFilter is a path segment selecting list items by their content instead of index. Filters are written in square brackets, either in the short form "[field=value]", that must match exactly one list item, or in JSONPath-like form "[?(@.field==value)]", that selects all matching list items, similarly to a wildcard (when only a single item may be addressed, it must also match exactly one item). Filters may be attached to the segment of the list field (as in "users[id=42].email") or follow it as a separate segment (as in "users.[id=42].email").
+//
+// Field is a path relative to the list item (with JSON names, proto names or field numbers) and an empty field (written as "@") compares the list item itself. Value may be a bare literal or a quoted string. It is compared with the textual representation of the selected scalar value; enums match both their names and numbers.
+type PathFilter struct {
+	Field Path
+	Value string
+	All   bool // JSONPath-like form that selects all matching list items
+}
+
+// IsFilter reports whether the segment is a filter.
+func (ps PathSegment) IsFilter() bool {
+	return isPathFilter(ps.raw()) && !isQuotedPathKey(ps.raw())
+}
+
+// Filter parses the segment as a filter.
+func (ps PathSegment) Filter() (PathFilter, error) {
+	return ParsePathFilter(ps.raw())
+}
+
+func isPathFilter(segment string) bool {
+	return strings.HasPrefix(segment, pathFilterStart) && strings.HasSuffix(segment, pathFilterEnd)
+}
+
+func isSelectingPathFilter(segment string) bool {
+	return strings.HasPrefix(segment, pathFilterStart+"?(") && isPathFilter(segment)
+}
+
+// ParsePathFilter parses the provided path segment as a filter. It returns ErrInvalidFilter error if the segment is not a valid filter.
+func ParsePathFilter(segment string) (PathFilter, error) {
+	if !isPathFilter(segment) {
+		return PathFilter{}, ErrInvalidFilter{Filter: segment}
+	}
+	expr, f := segment[1:len(segment)-1], PathFilter{}
+	if strings.HasPrefix(expr, "?(") && strings.HasSuffix(expr, ")") {
+		expr, f.All = expr[2:len(expr)-1], true
+	}
+	lhs, rhs, ok := cutFilterExpression(expr, f.All)
+	if !ok {
+		return PathFilter{}, ErrInvalidFilter{Filter: segment}
+	}
+
+	lhs = strings.TrimSpace(lhs)
+	switch {
+	case lhs == "@":
+	case strings.HasPrefix(lhs, "@"+PathSegmentSeparator):
+		f.Field = Path(lhs[2:])
+	case f.All || lhs == "":
+		return PathFilter{}, ErrInvalidFilter{Filter: segment}
+	default:
+		f.Field = Path(lhs)
+	}
+
+	rhs = strings.TrimSpace(rhs)
+	if strings.HasPrefix(rhs, `"`) {
+		v, err := strconv.Unquote(rhs)
+		if err != nil {
+			return PathFilter{}, ErrInvalidFilter{Filter: segment}
+		}
+		rhs = v
+	}
+	f.Value = rhs
+	return f, nil
+}
+
+// cutFilterExpression splits the filter expression around its comparison operator ("==" for JSONPath-like form and "=" otherwise).
+func cutFilterExpression(expr string, jsonPath bool) (string, string, bool) {
+	op := "="
+	if jsonPath {
+		op = "=="
+	}
+	i := strings.Index(expr, op)
+	if i < 0 {
+		return "", "", false
+	}
+	lhs, rhs := expr[:i], expr[i+len(op):]
+	if strings.HasPrefix(rhs, "=") { // "==" in short form
+		rhs = rhs[1:]
+	}
+	return lhs, rhs, true
+}
+
+// index returns index of the list item under the given key. The key may be an index or a filter matching exactly one item.
+func (c *listContainer) index(key string) (int, error) {
+	if !isPathFilter(key) {
+		return indexInList(c.li, key)
+	}
+	return c.filteredIndex(key)
+}
+
+// indexForInsert works like index, but allows index just after the last list item (also referred to with PathEndOfList).
+func (c *listContainer) indexForInsert(key string) (int, error) {
+	if key == PathEndOfList {
+		return c.li.Len(), nil
+	}
+	if !isPathFilter(key) {
+		return indexInListForInsert(c.li, key)
+	}
+	return c.filteredIndex(key)
+}
+
+func (c *listContainer) filteredIndex(key string) (int, error) {
+	f, err := ParsePathFilter(key)
+	if err != nil {
+		return 0, err
+	}
+	matching := c.matching(f)
+	switch len(matching) {
+	case 0:
+		return 0, ErrNotFound{Kind: "index", Value: key}
+	case 1:
+		return matching[0], nil
+	}
+	return 0, ErrAmbiguousFilter{Filter: key, Matches: len(matching)}
+}
+
+// matching returns indexes of all list items matching the given filter.
+func (c *listContainer) matching(f PathFilter) []int {
+	matching := []int(nil)
+	for i := 0; i < c.li.Len(); i++ {
+		v, field := c.li.Get(i), c.parentField
+		if f.Field != "" {
+			if field.Kind() != protoreflect.MessageKind {
+				return nil
+			}
+			var ok bool
+			if v, field, ok = valueInMessage(v.Message(), f.Field); !ok {
+				continue
+			}
+		}
+		if scalarMatchesFilter(v, field, f.Value) {
+			matching = append(matching, i)
+		}
+	}
+	return matching
+}
+
+// valueInMessage returns value and descriptor of the field pointed by the given path. Only singular message fields can be descended into.
+func valueInMessage(msg protoreflect.Message, path Path) (protoreflect.Value, protoreflect.FieldDescriptor, bool) {
+	for ps := range path.Iter {
+		field, err := fieldInMessage(msg.Descriptor().Fields(), ps.Value())
+		if err != nil || field == nil {
+			return protoreflect.Value{}, nil, false
+		}
+		if ps.IsLast() {
+			return msg.Get(field), field, !field.IsList() && !field.IsMap()
+		}
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			return protoreflect.Value{}, nil, false
+		}
+		msg = msg.Get(field).Message()
+	}
+	return protoreflect.Value{}, nil, false
+}
+
+// scalarMatchesFilter reports whether the textual representation of the given scalar value equals the filter value.
+func scalarMatchesFilter(v protoreflect.Value, field protoreflect.FieldDescriptor, value string) bool {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return v.String() == value
+	case protoreflect.BytesKind:
+		return string(v.Bytes()) == value
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		return err == nil && v.Bool() == b
+	case protoreflect.EnumKind:
+		if ev := field.Enum().Values().ByNumber(v.Enum()); ev != nil && string(ev.Name()) == value {
+			return true
+		}
+		n, err := strconv.ParseInt(value, 10, 32)
+		return err == nil && v.Enum() == protoreflect.EnumNumber(n)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(value, 10, 64)
+		return err == nil && v.Int() == n
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(value, 10, 64)
+		return err == nil && v.Uint() == n
+	case protoreflect.FloatKind:
+		n, err := strconv.ParseFloat(value, 32)
+		return err == nil && float32(v.Float()) == float32(n)
+	case protoreflect.DoubleKind:
+		n, err := strconv.ParseFloat(value, 64)
+		return err == nil && v.Float() == n
+	}
+	return false
+}
+
+// resolveFilters returns the path with all filter segments replaced by indexes of the list items they match.
+func resolveFilters(base proto.Message, path Path, setup *setup) (Path, error) {
+	if !strings.Contains(string(path), pathFilterStart) {
+		return path, nil
+	}
+	resolved := Path("")
+	for ps := range path.Iter {
+		segment := ps.raw()
+		if ps.IsFilter() {
+			c, err := transformContainer(MessageContainer(base), setup)
+			if !ps.IsFirst() {
+				c, err = access(MessageContainer(base), resolved, setup)
+			}
+			if err != nil {
+				return "", err
+			}
+			if lc, ok := unwrapContainer(c).(*listContainer); ok {
+				idx, err := lc.index(ps.Value())
+				if err != nil {
+					return "", NewErrInPath(string(resolved), err)
+				}
+				segment = strconv.Itoa(idx)
+			}
+		}
+		if ps.IsFirst() {
+			resolved = Path(segment)
+		} else {
+			resolved = resolved.JoinSegmentValue(segment)
+		}
+	}
+	return resolved, nil
+}
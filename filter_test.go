@@ -0,0 +1,215 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+		String_: []string{"a", "b.c", "d"},
+		Int32:   []int32{1, 2, 2},
+		Message: []*protopatchv1.TestMessage{
+			{String_: "a", Int64: 1, Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+			{String_: "b", Int64: 2, Message: &protopatchv1.TestMessage{Bool: true}},
+			{String_: "c", Int64: 2},
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		patch   protopatch.Patch
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name: "set-field-of-matching-item",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message[string=b].int64", Value: int64(5)},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "b.c", "d"},
+				Int32:   []int32{1, 2, 2},
+				Message: []*protopatchv1.TestMessage{
+					{String_: "a", Int64: 1, Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+					{String_: "b", Int64: 5, Message: &protopatchv1.TestMessage{Bool: true}},
+					{String_: "c", Int64: 2},
+				},
+			}},
+		},
+		{
+			name: "separate-segment",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message.[string=\"c\"].string", Value: "x"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "b.c", "d"},
+				Int32:   []int32{1, 2, 2},
+				Message: []*protopatchv1.TestMessage{
+					{String_: "a", Int64: 1, Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+					{String_: "b", Int64: 2, Message: &protopatchv1.TestMessage{Bool: true}},
+					{String_: "x", Int64: 2},
+				},
+			}},
+		},
+		{
+			name: "clear-scalar-item",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.string[@=\"b.c\"]"},
+				{Op: protopatch.OpClear, Path: "list.int32[@=1]"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "d"},
+				Int32:   []int32{2, 2},
+				Message: []*protopatchv1.TestMessage{
+					{String_: "a", Int64: 1, Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+					{String_: "b", Int64: 2, Message: &protopatchv1.TestMessage{Bool: true}},
+					{String_: "c", Int64: 2},
+				},
+			}},
+		},
+		{
+			name: "nested-field-and-enum",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.message[message.bool=true].message"},
+				{Op: protopatch.OpSet, Path: "list.message[enum=ENUM_VALUE_OTHER].int64", Value: int64(0)},
+				{Op: protopatch.OpInsert, Path: "list.message[string=c]", Value: &protopatchv1.TestMessage{String_: "x"}},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "b.c", "d"},
+				Int32:   []int32{1, 2, 2},
+				Message: []*protopatchv1.TestMessage{
+					{String_: "a", Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+					{String_: "b", Int64: 2},
+					{String_: "x"},
+					{String_: "c", Int64: 2},
+				},
+			}},
+		},
+		{
+			name: "select-all-matching",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message[?(@.int64==2)].string", Value: "x"},
+				{Op: protopatch.OpClear, Path: "list.int32[?(@==2)]"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "b.c", "d"},
+				Int32:   []int32{1},
+				Message: []*protopatchv1.TestMessage{
+					{String_: "a", Int64: 1, Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+					{String_: "x", Int64: 2, Message: &protopatchv1.TestMessage{Bool: true}},
+					{String_: "x", Int64: 2},
+				},
+			}},
+		},
+		{
+			name: "change-filtered-field",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message[string=a].string", Value: "z"},
+				{Op: protopatch.OpTest, Path: "list.message[string=z].int64", Value: int64(1)},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "b.c", "d"},
+				Int32:   []int32{1, 2, 2},
+				Message: []*protopatchv1.TestMessage{
+					{String_: "z", Int64: 1, Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+					{String_: "b", Int64: 2, Message: &protopatchv1.TestMessage{Bool: true}},
+					{String_: "c", Int64: 2},
+				},
+			}},
+		},
+		{
+			name: "not-found",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message[string=x].int64", Value: int64(1)},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpSet, Cause: protopatch.NewErrInPath("list.message", protopatch.ErrNotFound{Kind: "index", Value: "[string=x]"})},
+		},
+		{
+			name: "ambiguous",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.message[int64=2]"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpClear, Cause: protopatch.NewErrInPath("list.message", protopatch.ErrAmbiguousFilter{Filter: "[int64=2]", Matches: 2})},
+		},
+		{
+			name: "ambiguous-select-all-in-copy",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpCopy, Path: "string", From: "list.message[?(@.int64==2)].string"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpCopy, Cause: protopatch.NewErrInPath("list.message", protopatch.ErrAmbiguousFilter{Filter: "[?(@.int64==2)]", Matches: 2})},
+		},
+		{
+			name: "invalid",
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.message[string]"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpClear, Cause: protopatch.NewErrInPath("list.message", protopatch.ErrInvalidFilter{Filter: "[string]"})},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(base)
+			err := protopatch.Apply(msg, test.patch)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, msg, "message after apply mismatch")
+
+			msg = proto.Clone(base)
+			inverse, err := protopatch.ApplyWithInverse(msg, test.patch)
+			require.NoError(t, err)
+			require.NoError(t, protopatch.Apply(msg, inverse))
+			patchtest.RequireEqual(t, base, msg, "message after applying inverse mismatch")
+		})
+	}
+}
+
+func TestParsePathFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		segment string
+		want    protopatch.PathFilter
+		wantErr bool
+	}{
+		{segment: "[id=42]", want: protopatch.PathFilter{Field: "id", Value: "42"}},
+		{segment: "[id==42]", want: protopatch.PathFilter{Field: "id", Value: "42"}},
+		{segment: "[ a.b = \"x=y\" ]", want: protopatch.PathFilter{Field: "a.b", Value: "x=y"}},
+		{segment: "[@=x]", want: protopatch.PathFilter{Value: "x"}},
+		{segment: "[@.id=]", want: protopatch.PathFilter{Field: "id"}},
+		{segment: "[?(@.sku==\"X\")]", want: protopatch.PathFilter{Field: "sku", Value: "X", All: true}},
+		{segment: "[?(@==1)]", want: protopatch.PathFilter{Value: "1", All: true}},
+		{segment: "[?(sku==1)]", wantErr: true},
+		{segment: "[?(@.sku=1)]", wantErr: true},
+		{segment: "[=1]", wantErr: true},
+		{segment: "[id]", wantErr: true},
+		{segment: "[id=\"x]", wantErr: true},
+		{segment: "id=1", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.segment, func(t *testing.T) {
+			t.Parallel()
+			got, err := protopatch.ParsePathFilter(test.segment)
+			if test.wantErr {
+				require.ErrorAs(t, err, &protopatch.ErrInvalidFilter{})
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+}
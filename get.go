@@ -0,0 +1,72 @@
+package protopatch
+
+import "google.golang.org/protobuf/proto"
+
+// Get returns the value pointed by the given path. For scalar types and messages it returns its value and for lists and maps it returns List and Map interfaces accordingly (values are not copied, so modifying returned messages, lists or maps modifies the base message). Containers are transformed with the configured ContainerTransformer options, so values within transformed containers (like structpb.Value) are accessible as well. An empty path refers to the base message.
+func Get(base proto.Message, path string, opts ...Option) (any, error) {
+	return getWithSetup(base, path, newSetup(opts...))
+}
+
+func getWithSetup(base proto.Message, path string, setup *setup) (any, error) {
+	if path == "" { // special case - an empty path; get the base message
+		return base, nil
+	}
+	c, last, err := accessParent(base, Path(path), setup)
+	if err != nil {
+		return nil, err
+	}
+	v, err := c.Get(last.Value())
+	if err != nil {
+		if last.IsFirst() {
+			return nil, err
+		}
+		return nil, NewErrInPath(string(last.PrecedingPath()), err)
+	}
+	return v, nil
+}
+
+// Has reports whether the given path points to an existing and populated value, that is a set message field (for fields without presence, a field holding non-zero value or a non-empty list or map), an existing list index or an existing map key. Paths that cannot be accessed, for example paths referring to nonexistent fields or descending into unset messages, are reported as not populated.
+func Has(base proto.Message, path string, opts ...Option) bool {
+	if path == "" { // special case - an empty path; the base message
+		return base.ProtoReflect().IsValid()
+	}
+	c, last, err := accessParent(base, Path(path), newSetup(opts...))
+	if err != nil {
+		return false
+	}
+	if _, err := c.Get(last.Value()); err != nil {
+		return false
+	}
+	return isPopulated(c, last.Value())
+}
+
+// GetAs works like Get, but returns the value as T. Lists and maps are returned as Go slices and maps (as returned by List.AsGoSlice and Map.AsGoMap) when T is of such type. Values of other types are converted with the configured converters, using zero value of T as the conversion target. If the value cannot be represented as T, it returns ErrMismatchingType error wrapped in ErrInPath error.
+func GetAs[T any](base proto.Message, path string, opts ...Option) (T, error) {
+	var zero T
+	setup := newSetup(opts...)
+	v, err := getWithSetup(base, path, setup)
+	if err != nil {
+		return zero, err
+	}
+	if t, ok := v.(T); ok {
+		return t, nil
+	}
+	if li, ok := v.(List); ok {
+		if t, ok := li.AsGoSlice().(T); ok {
+			return t, nil
+		}
+	}
+	if ma, ok := v.(Map); ok {
+		if t, ok := ma.AsGoMap().(T); ok {
+			return t, nil
+		}
+	}
+	conv, err := convert(zero, v, setup)
+	if err != nil {
+		return zero, NewErrInPath(path, err)
+	}
+	if t, ok := conv.(T); ok {
+		return t, nil
+	}
+	return zero, NewErrInPath(path, ErrMismatchingType)
+}
@@ -0,0 +1,137 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func getTestMessage() *protopatchv1.TestMessage {
+	return &protopatchv1.TestMessage{
+		String_: "a",
+		Message: &protopatchv1.TestMessage{Int32: 1},
+		List:    &protopatchv1.TestList{String_: []string{"a", "b"}, Message: []*protopatchv1.TestMessage{{String_: "a"}}},
+		Map:     &protopatchv1.TestMap{StringToString: map[string]string{"a.b": "c"}},
+		WellKnown: &protopatchv1.TestWellKnown{
+			Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"a": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("x")}}),
+			}}),
+		},
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	base := getTestMessage()
+	transform := protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())
+
+	tests := []struct {
+		name    string
+		path    string
+		opts    []protopatch.Option
+		want    any
+		wantErr error
+	}{
+		{name: "base", path: "", want: base},
+		{name: "scalar", path: "string", want: "a"},
+		{name: "unset-scalar", path: "int64", want: int64(0)},
+		{name: "nested-scalar", path: "message.int32", want: int32(1)},
+		{name: "message", path: "list.message.0", want: &protopatchv1.TestMessage{String_: "a"}},
+		{name: "list-item", path: "list.string.-1", want: "b"},
+		{name: "filtered-list-item", path: "list.string[@=b]", want: "b"},
+		{name: "map-value", path: `map.stringToString.a\.b`, want: "c"},
+		{name: "transformed", path: "wellKnown.value.a.0", opts: []protopatch.Option{transform}, want: structpb.NewStringValue("x")},
+		{name: "not-transformed", path: "wellKnown.value.a.0", wantErr: protopatch.NewErrInPath("wellKnown.value", protopatch.ErrNotFound{Kind: "field", Value: "a"})},
+		{name: "unknown-field", path: "unknown", wantErr: protopatch.ErrNotFound{Kind: "field", Value: "unknown"}},
+		{name: "unknown-field-number", path: "message.99", wantErr: protopatch.NewErrInPath("message", protopatch.ErrNotFound{Kind: "field", Value: "99"})},
+		{name: "missing-index", path: "list.string.2", wantErr: protopatch.NewErrInPath("list.string", protopatch.ErrNotFound{Kind: "index", Value: "2"})},
+		{name: "missing-key", path: "map.stringToString.a", wantErr: protopatch.NewErrInPath("map.stringToString", protopatch.ErrNotFound{Kind: "key", Value: "a"})},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := protopatch.Get(base, test.path, test.opts...)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			if m, ok := test.want.(proto.Message); ok {
+				patchtest.RequireEqual(t, m, got.(proto.Message), "value mismatch")
+				return
+			}
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestHas(t *testing.T) {
+	t.Parallel()
+
+	base := getTestMessage()
+	transform := protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())
+
+	require.True(t, protopatch.Has(base, ""))
+	require.True(t, protopatch.Has(base, "string"))
+	require.False(t, protopatch.Has(base, "int64"))
+	require.True(t, protopatch.Has(base, "message"))
+	require.False(t, protopatch.Has(base, "oneof"))
+	require.False(t, protopatch.Has(base, "oneof.string"))
+	require.True(t, protopatch.Has(base, "list.string"))
+	require.False(t, protopatch.Has(base, "list.int32"))
+	require.True(t, protopatch.Has(base, "list.string.1"))
+	require.False(t, protopatch.Has(base, "list.string.2"))
+	require.True(t, protopatch.Has(base, `map.stringToString["a.b"]`))
+	require.False(t, protopatch.Has(base, "map.stringToString.a"))
+	require.False(t, protopatch.Has(base, "unknown"))
+	require.False(t, protopatch.Has(base, "99"))
+	require.False(t, protopatch.Has(base, "string.length"))
+	require.True(t, protopatch.Has(base, "wellKnown.value.a.0", transform))
+	require.False(t, protopatch.Has(base, "wellKnown.value.b", transform))
+	require.False(t, protopatch.Has((*protopatchv1.TestMessage)(nil), ""))
+}
+
+func TestGetAs(t *testing.T) {
+	t.Parallel()
+
+	base := getTestMessage()
+
+	s, err := protopatch.GetAs[string](base, "list.string.0")
+	require.NoError(t, err)
+	require.Equal(t, "a", s)
+
+	m, err := protopatch.GetAs[*protopatchv1.TestMessage](base, "message")
+	require.NoError(t, err)
+	patchtest.RequireEqual(t, base.Message, m, "message mismatch")
+
+	li, err := protopatch.GetAs[[]string](base, "list.string")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, li)
+
+	ma, err := protopatch.GetAs[map[string]string](base, "map.stringToString")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a.b": "c"}, ma)
+
+	opts := []protopatch.Option{
+		protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer()),
+		protopatch.WithConversion(patchstructpb.ToValueConverter()),
+	}
+	s, err = protopatch.GetAs[string](base, "wellKnown.value.a.0", opts...)
+	require.NoError(t, err)
+	require.Equal(t, "x", s)
+
+	_, err = protopatch.GetAs[int32](base, "string")
+	require.Equal(t, protopatch.NewErrInPath("string", protopatch.ErrMismatchingType), err)
+
+	_, err = protopatch.GetAs[string](base, "unknown")
+	require.Equal(t, protopatch.ErrNotFound{Kind: "field", Value: "unknown"}, err)
+}
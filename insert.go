@@ -5,6 +5,8 @@ import (
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daishe/protopatch/internal/protoops"
 )
 
 func Insert(base proto.Message, path string, new any, opts ...Option) error {
@@ -12,6 +14,12 @@ func Insert(base proto.Message, path string, new any, opts ...Option) error {
 }
 
 func insertWithSetup(base proto.Message, path string, new any, setup *setup) error {
+	if err := checkNoWildcard(path, OpInsert); err != nil {
+		return err
+	}
+	if err := checkPath(base, path, setup); err != nil {
+		return err
+	}
 	if path == "" { // special case - an empty path; insert to the base message
 		return ErrInsertToNonList
 	}
@@ -24,7 +32,7 @@ func insertWithSetup(base proto.Message, path string, new any, setup *setup) err
 		if err != nil {
 			return err
 		}
-		ref, err := newElementForInsertInContainer(a)
+		ref, err := newElementForInsertInContainer(a, last.Value())
 		if err != nil {
 			return NewErrInPath(string(last.PrecedingPath()), err)
 		}
@@ -44,7 +52,8 @@ func insertWithSetup(base proto.Message, path string, new any, setup *setup) err
 	if err != nil {
 		return err
 	}
-	ref, err := newElementForInsertInContainer(a)
+	key := p.Last().Value()
+	ref, err := newElementForInsertInContainer(a, key)
 	if err != nil {
 		return err
 	}
@@ -52,28 +61,31 @@ func insertWithSetup(base proto.Message, path string, new any, setup *setup) err
 	if err != nil {
 		return NewErrInPath(path, err)
 	}
-	err = c.Insert(path, conv)
+	err = a.Insert(key, conv)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func newElementForInsertInContainer(c Container) (any, error) {
-	var kind protoreflect.Kind
+func newElementForInsertInContainer(c Container, key string) (any, error) {
+	if !isOwnContainer(c) { // containers not managed by this package know their elements best
+		return c.GetNew(key)
+	}
+	var value protoreflect.FieldDescriptor
 	var val protoreflect.Value
 	switch x := c.Self().(type) {
 	case List:
-		kind, val = x.ParentFieldDescriptor().Kind(), x.NewElement()
+		value, val = x.ParentFieldDescriptor(), x.NewElement()
 	case Map:
-		kind, val = x.ParentFieldDescriptor().MapValue().Kind(), x.NewValue()
+		value, val = x.ParentFieldDescriptor().MapValue(), x.NewValue()
 	default:
 		return nil, ErrInsertToNonList
 	}
-	if kind == protoreflect.MessageKind {
+	if value.Kind() == protoreflect.MessageKind {
 		return val.Message().Interface(), nil
 	}
-	return val.Interface(), nil
+	return protoops.EnumRef(value.Enum(), val.Interface()), nil
 }
 
 func (c *messageContainer) Insert(key string, new any) error {
@@ -87,7 +99,10 @@ func (c *listContainer) Insert(key string, new any) error {
 	if c.ro {
 		return ErrMutationOfReadOnlyValue
 	}
-	idx, err := indexInListForInsert(c.li, key)
+	if err := c.guard.mutable(); err != nil {
+		return err
+	}
+	idx, err := c.indexForInsert(key)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,43 @@
+package protoops
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// enumValue is an enum value carrying its enum descriptor, so that it can be resolved by name even when the Go type of the enum is not known.
+type enumValue struct {
+	desc protoreflect.EnumDescriptor
+	num  protoreflect.EnumNumber
+}
+
+// NewEnum returns an enum value of the given enum with the given number.
+func NewEnum(desc protoreflect.EnumDescriptor, num protoreflect.EnumNumber) protoreflect.Enum {
+	return enumValue{desc: desc, num: num}
+}
+
+func (e enumValue) Descriptor() protoreflect.EnumDescriptor { return e.desc }
+func (e enumValue) Type() protoreflect.EnumType             { return dynamicpb.NewEnumType(e.desc) }
+func (e enumValue) Number() protoreflect.EnumNumber         { return e.num }
+
+// EnumRef returns the given new value of an item of the given enum as an enum value carrying its descriptor (see NewEnum), so that it can be used as a conversion target. Values of other types and values of non enum items (enum is nil) are returned as they are.
+func EnumRef(enum protoreflect.EnumDescriptor, ref any) any {
+	if n, ok := ref.(protoreflect.EnumNumber); ok && enum != nil {
+		return NewEnum(enum, n)
+	}
+	return ref
+}
+
+// AsEnumRef returns the given value as an enum value carrying its descriptor, if it was created by NewEnum or EnumRef.
+func AsEnumRef(v any) (protoreflect.Enum, bool) {
+	e, ok := v.(enumValue)
+	return e, ok
+}
+
+// EnumNumberOf returns the number of the given enum value carrying its descriptor (see NewEnum). Values of other types are returned as they are.
+func EnumNumberOf(v any) any {
+	if e, ok := v.(enumValue); ok {
+		return e.num
+	}
+	return v
+}
@@ -72,7 +72,7 @@ func clearMessageFieldListItem(parentMessage protoreflect.Message, listField pro
 func clearListItem(li protoreflect.List, index int) {
 	len := li.Len()
 	for i := index + 1; i < len; i++ {
-		li.Set(i-1, li.Get(i+1))
+		li.Set(i-1, li.Get(i))
 	}
 	li.Truncate(len - 1)
 }
@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: protopatch/v1/field_behavior.proto
+
+package protopatchv1
+
+import (
+	_ "github.com/daishe/protopatch/internal/testtypes/google/api"
+	_ "github.com/daishe/protopatch/types/protopatch/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TestFieldBehavior struct {
+	state            protoimpl.MessageState        `protogen:"open.v1"`
+	Id               string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	State            string                        `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	CreateTime       string                        `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Owner            string                        `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	ImmutableMessage *TestFieldBehavior            `protobuf:"bytes,6,opt,name=immutable_message,json=immutableMessage,proto3" json:"immutable_message,omitempty"`
+	ImmutableList    []string                      `protobuf:"bytes,7,rep,name=immutable_list,json=immutableList,proto3" json:"immutable_list,omitempty"`
+	ImmutableMap     map[string]string             `protobuf:"bytes,8,rep,name=immutable_map,json=immutableMap,proto3" json:"immutable_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Message          *TestFieldBehavior            `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	List             []*TestFieldBehavior          `protobuf:"bytes,10,rep,name=list,proto3" json:"list,omitempty"`
+	Map              map[string]*TestFieldBehavior `protobuf:"bytes,11,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*TestFieldBehavior_ImmutableChoice
+	//	*TestFieldBehavior_FreeChoice
+	//	*TestFieldBehavior_MessageChoice
+	Choice        isTestFieldBehavior_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestFieldBehavior) Reset() {
+	*x = TestFieldBehavior{}
+	mi := &file_protopatch_v1_field_behavior_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestFieldBehavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestFieldBehavior) ProtoMessage() {}
+
+func (x *TestFieldBehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_protopatch_v1_field_behavior_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestFieldBehavior.ProtoReflect.Descriptor instead.
+func (*TestFieldBehavior) Descriptor() ([]byte, []int) {
+	return file_protopatch_v1_field_behavior_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestFieldBehavior) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestFieldBehavior) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestFieldBehavior) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TestFieldBehavior) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *TestFieldBehavior) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TestFieldBehavior) GetImmutableMessage() *TestFieldBehavior {
+	if x != nil {
+		return x.ImmutableMessage
+	}
+	return nil
+}
+
+func (x *TestFieldBehavior) GetImmutableList() []string {
+	if x != nil {
+		return x.ImmutableList
+	}
+	return nil
+}
+
+func (x *TestFieldBehavior) GetImmutableMap() map[string]string {
+	if x != nil {
+		return x.ImmutableMap
+	}
+	return nil
+}
+
+func (x *TestFieldBehavior) GetMessage() *TestFieldBehavior {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *TestFieldBehavior) GetList() []*TestFieldBehavior {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *TestFieldBehavior) GetMap() map[string]*TestFieldBehavior {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *TestFieldBehavior) GetChoice() isTestFieldBehavior_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *TestFieldBehavior) GetImmutableChoice() string {
+	if x != nil {
+		if x, ok := x.Choice.(*TestFieldBehavior_ImmutableChoice); ok {
+			return x.ImmutableChoice
+		}
+	}
+	return ""
+}
+
+func (x *TestFieldBehavior) GetFreeChoice() string {
+	if x != nil {
+		if x, ok := x.Choice.(*TestFieldBehavior_FreeChoice); ok {
+			return x.FreeChoice
+		}
+	}
+	return ""
+}
+
+func (x *TestFieldBehavior) GetMessageChoice() *TestFieldBehavior {
+	if x != nil {
+		if x, ok := x.Choice.(*TestFieldBehavior_MessageChoice); ok {
+			return x.MessageChoice
+		}
+	}
+	return nil
+}
+
+type isTestFieldBehavior_Choice interface {
+	isTestFieldBehavior_Choice()
+}
+
+type TestFieldBehavior_ImmutableChoice struct {
+	ImmutableChoice string `protobuf:"bytes,12,opt,name=immutable_choice,json=immutableChoice,proto3,oneof"`
+}
+
+type TestFieldBehavior_FreeChoice struct {
+	FreeChoice string `protobuf:"bytes,13,opt,name=free_choice,json=freeChoice,proto3,oneof"`
+}
+
+type TestFieldBehavior_MessageChoice struct {
+	MessageChoice *TestFieldBehavior `protobuf:"bytes,14,opt,name=message_choice,json=messageChoice,proto3,oneof"`
+}
+
+func (*TestFieldBehavior_ImmutableChoice) isTestFieldBehavior_Choice() {}
+
+func (*TestFieldBehavior_FreeChoice) isTestFieldBehavior_Choice() {}
+
+func (*TestFieldBehavior_MessageChoice) isTestFieldBehavior_Choice() {}
+
+var File_protopatch_v1_field_behavior_proto protoreflect.FileDescriptor
+
+var file_protopatch_v1_field_behavior_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xff, 0x06, 0x0a, 0x11, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0xda, 0xf6, 0x18, 0x02, 0x08, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xda, 0xf6, 0x18, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe0, 0x41, 0x02, 0xe0, 0x41, 0x05, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x11, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x42, 0x06, 0xda, 0xf6, 0x18, 0x02, 0x08, 0x01, 0x52, 0x10, 0x69, 0x6d, 0x6d, 0x75,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x0e,
+	0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xda, 0xf6, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0d, 0x69, 0x6d,
+	0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0d, 0x69,
+	0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x2e, 0x49, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x06, 0xda, 0xf6, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0c,
+	0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x3a, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x33, 0x0a, 0x10, 0x69,
+	0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xda, 0xf6, 0x18, 0x02, 0x08, 0x01, 0x48, 0x00, 0x52,
+	0x0f, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x1a, 0x3f,
+	0x0a, 0x11, 0x49, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x58, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x42, 0xc8, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x69, 0x73,
+	0x68, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58,
+	0x58, 0xaa, 0x02, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protopatch_v1_field_behavior_proto_rawDescOnce sync.Once
+	file_protopatch_v1_field_behavior_proto_rawDescData = file_protopatch_v1_field_behavior_proto_rawDesc
+)
+
+func file_protopatch_v1_field_behavior_proto_rawDescGZIP() []byte {
+	file_protopatch_v1_field_behavior_proto_rawDescOnce.Do(func() {
+		file_protopatch_v1_field_behavior_proto_rawDescData = protoimpl.X.CompressGZIP(file_protopatch_v1_field_behavior_proto_rawDescData)
+	})
+	return file_protopatch_v1_field_behavior_proto_rawDescData
+}
+
+var file_protopatch_v1_field_behavior_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protopatch_v1_field_behavior_proto_goTypes = []any{
+	(*TestFieldBehavior)(nil), // 0: protopatch.v1.TestFieldBehavior
+	nil,                       // 1: protopatch.v1.TestFieldBehavior.ImmutableMapEntry
+	nil,                       // 2: protopatch.v1.TestFieldBehavior.MapEntry
+}
+var file_protopatch_v1_field_behavior_proto_depIdxs = []int32{
+	0, // 0: protopatch.v1.TestFieldBehavior.immutable_message:type_name -> protopatch.v1.TestFieldBehavior
+	1, // 1: protopatch.v1.TestFieldBehavior.immutable_map:type_name -> protopatch.v1.TestFieldBehavior.ImmutableMapEntry
+	0, // 2: protopatch.v1.TestFieldBehavior.message:type_name -> protopatch.v1.TestFieldBehavior
+	0, // 3: protopatch.v1.TestFieldBehavior.list:type_name -> protopatch.v1.TestFieldBehavior
+	2, // 4: protopatch.v1.TestFieldBehavior.map:type_name -> protopatch.v1.TestFieldBehavior.MapEntry
+	0, // 5: protopatch.v1.TestFieldBehavior.message_choice:type_name -> protopatch.v1.TestFieldBehavior
+	0, // 6: protopatch.v1.TestFieldBehavior.MapEntry.value:type_name -> protopatch.v1.TestFieldBehavior
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_protopatch_v1_field_behavior_proto_init() }
+func file_protopatch_v1_field_behavior_proto_init() {
+	if File_protopatch_v1_field_behavior_proto != nil {
+		return
+	}
+	file_protopatch_v1_field_behavior_proto_msgTypes[0].OneofWrappers = []any{
+		(*TestFieldBehavior_ImmutableChoice)(nil),
+		(*TestFieldBehavior_FreeChoice)(nil),
+		(*TestFieldBehavior_MessageChoice)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protopatch_v1_field_behavior_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protopatch_v1_field_behavior_proto_goTypes,
+		DependencyIndexes: file_protopatch_v1_field_behavior_proto_depIdxs,
+		MessageInfos:      file_protopatch_v1_field_behavior_proto_msgTypes,
+	}.Build()
+	File_protopatch_v1_field_behavior_proto = out.File
+	file_protopatch_v1_field_behavior_proto_rawDesc = nil
+	file_protopatch_v1_field_behavior_proto_goTypes = nil
+	file_protopatch_v1_field_behavior_proto_depIdxs = nil
+}
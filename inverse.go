@@ -0,0 +1,309 @@
+package protopatch
+
+import (
+	"slices"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ApplyWithInverse works like Apply, but additionally returns the inverse patch, that is a patch that, when applied to the resulting message, restores the base message to its original state. Inverse operations are computed before each operation is applied, using copies of the original values (as returned by GetCopy method of containers). An operation whose inverse cannot be computed fails without being applied.
+//
+// When any operation fails, the returned inverse patch reverts only operations applied successfully before the failing one. When WithRollback option is provided, the base message is restored on failure and the returned inverse patch is empty.
+func ApplyWithInverse(base proto.Message, patch Patch, opts ...Option) (Patch, error) {
+	inverse := Patch{}
+	err := applyWithSetup(base, patch, newSetup(opts...), &inverse)
+	return inverse, err
+}
+
+// inverter computes inverse operations for operations applied to its base message.
+type inverter struct {
+	base  proto.Message
+	setup *setup
+}
+
+// operation returns operations reverting the provided operation. It must be called before the operation is applied. It returns error when the inverse cannot be computed, which usually means that the operation fails the same way.
+func (inv inverter) operation(op Operation) (Patch, error) {
+	ops, err := expandOperation(inv.base, op, inv.setup)
+	if err != nil {
+		return nil, err
+	}
+	if len(ops) != 1 || ops[0].Path != op.Path { // wildcard expanded; revert expanded operations in reverse order
+		inverse := Patch{}
+		for i := len(ops) - 1; i >= 0; i-- {
+			ops, err := inv.operation(ops[i])
+			if err != nil {
+				return nil, err
+			}
+			inverse = append(inverse, ops...)
+		}
+		return inverse, nil
+	}
+	// filters may stop matching after the operation is applied; inverse operations refer to list items by index
+	path, err := resolveFilters(inv.base, Path(op.Path), inv.setup)
+	if err != nil {
+		return nil, err
+	}
+	from, err := resolveFilters(inv.base, Path(op.From), inv.setup)
+	if err != nil {
+		return nil, err
+	}
+	op.Path, op.From = string(literalPath(path)), string(literalPath(from)) // inverse operations may be expanded, even though the operation is not
+	switch op.Op {
+	case OpSet, OpCopy:
+		if op.Op == OpSet && op.Value == nil { // set to nil clears the target element
+			return inv.clear(Path(op.Path))
+		}
+		return inv.restore(Path(op.Path))
+	case OpClear:
+		return inv.clear(Path(op.Path))
+	case OpAppend:
+		return inv.append(Path(op.Path))
+	case OpInsert:
+		return inv.insert(Path(op.Path))
+	case OpMove:
+		return inv.move(Path(op.Path), Path(op.From))
+	case OpSwap:
+		return inv.swap(Path(op.Path), Path(op.From))
+	}
+	return nil, nil
+}
+
+// parent returns container holding the last segment of the given (non empty) path.
+func (inv inverter) parent(path Path) (Container, PathSegment, error) {
+	last := path.Last()
+	c := MessageContainer(inv.base)
+	if last.IsFirst() {
+		c, err := transformContainer(c, inv.setup)
+		return c, last, err
+	}
+	c, err := access(c, last.PrecedingPath(), inv.setup)
+	return c, last, err
+}
+
+// inParent wraps error returned by the container holding the given segment, in the same way as operations do.
+func inParent(last PathSegment, err error) error {
+	if last.IsFirst() {
+		return err
+	}
+	return NewErrInPath(string(last.PrecedingPath()), err)
+}
+
+// restore returns operations setting the value pointed by the given path to its current value. When the value is held by a container not managed by this package, the whole container value is restored instead.
+func (inv inverter) restore(path Path) (Patch, error) {
+	if path == "" { // special case - an empty path; restore the base message
+		return Patch{{Op: OpSet, Path: "", Value: proto.Clone(inv.base)}}, nil
+	}
+	c, last, err := inv.parent(path)
+	if err != nil {
+		return nil, err
+	}
+	switch c := unwrapContainer(c).(type) {
+	case *messageContainer:
+		if !c.msg.IsValid() { // unpopulated message cannot be modified, so the operation fails anyway
+			return nil, nil
+		}
+		field, err := fieldInMessage(c.msg.Descriptor().Fields(), last.Value())
+		if err != nil {
+			return nil, inParent(last, err)
+		}
+		if oneof := field.ContainingOneof(); oneof != nil {
+			if set := c.msg.WhichOneof(oneof); set != nil && set != field { // setting the field clears the other one; restoring the other one clears the field
+				setPath := siblingPath(last, set.JSONName())
+				v, err := c.GetCopy(set.JSONName())
+				if err != nil {
+					return nil, inParent(last, err)
+				}
+				return Patch{{Op: OpSet, Path: string(setPath), Value: v}}, nil
+			}
+		}
+		if !c.msg.Has(field) {
+			return Patch{{Op: OpClear, Path: string(path)}}, nil
+		}
+	case *mapContainer:
+		if _, err := keyInMap(c.ma, c.parentField.MapKey(), last.Value()); err != nil {
+			return Patch{{Op: OpClear, Path: string(path)}}, nil
+		}
+	case *listContainer:
+	default:
+		return inv.restoreParent(last)
+	}
+	v, err := c.GetCopy(last.Value())
+	if err != nil {
+		return nil, inParent(last, err)
+	}
+	return Patch{{Op: OpSet, Path: string(path), Value: v}}, nil
+}
+
+func (inv inverter) restoreParent(last PathSegment) (Patch, error) {
+	if last.IsFirst() {
+		return inv.restore("")
+	}
+	return inv.restore(last.PrecedingPath())
+}
+
+func (inv inverter) clear(path Path) (Patch, error) {
+	if path == "" {
+		return inv.restore("")
+	}
+	c, last, err := inv.parent(path)
+	if err != nil {
+		return nil, err
+	}
+	li, ok := unwrapContainer(c).(*listContainer)
+	if !ok {
+		return inv.restore(path)
+	}
+	idx, err := li.index(last.Value())
+	if err != nil {
+		return nil, inParent(last, err)
+	}
+	v, err := li.GetCopy(last.Value())
+	if err != nil {
+		return nil, inParent(last, err)
+	}
+	return Patch{{Op: OpInsert, Path: string(siblingPath(last, strconv.Itoa(idx))), Value: v}}, nil
+}
+
+func (inv inverter) append(path Path) (Patch, error) {
+	c, err := access(MessageContainer(inv.base), path, inv.setup)
+	if err != nil {
+		return nil, err
+	}
+	li, ok := unwrapContainer(c).(*listContainer)
+	if !ok {
+		return inv.restore(path)
+	}
+	return Patch{{Op: OpClear, Path: string(path.JoinSegmentValue(strconv.Itoa(li.li.Len())))}}, nil
+}
+
+func (inv inverter) insert(path Path) (Patch, error) {
+	if path == "" { // insert to the base message always fails
+		return nil, nil
+	}
+	c, last, err := inv.parent(path)
+	if err != nil {
+		return nil, err
+	}
+	li, ok := unwrapContainer(c).(*listContainer)
+	if !ok {
+		return inv.restoreParent(last)
+	}
+	idx, err := li.indexForInsert(last.Value())
+	if err != nil {
+		return nil, inParent(last, err)
+	}
+	return Patch{{Op: OpClear, Path: string(siblingPath(last, strconv.Itoa(idx)))}}, nil
+}
+
+// move returns a reverse move followed by operation restoring the target. When any of the elements is a list item (so the move shifts list indexes) or the paths overlap, it returns the composition of inverse set and clear operations instead.
+func (inv inverter) move(target, replacement Path) (Patch, error) {
+	if target == replacement {
+		return inv.restore(target)
+	}
+	if target != "" && replacement != "" && !isPathPrefix(target, replacement) && !isPathPrefix(replacement, target) && inv.isKeyed(target) && inv.isKeyed(replacement) {
+		restore, err := inv.restore(target)
+		if err != nil {
+			return nil, err
+		}
+		if len(restore) == 1 && restore[0].Op == OpClear { // target is already cleared by the reverse move
+			restore = nil
+		}
+		return append(Patch{{Op: OpMove, Path: string(replacement), From: string(target)}}, restore...), nil
+	}
+	clear, err := inv.clear(replacement)
+	if err != nil {
+		return nil, err
+	}
+	restore, err := inv.restore(target)
+	if err != nil {
+		return nil, err
+	}
+	return append(clear, restore...), nil
+}
+
+// swap returns a reverse swap. When any of the elements is a oneof member or is not populated (so swapping changes presence of fields), it returns operations restoring both elements instead.
+func (inv inverter) swap(first, second Path) (Patch, error) {
+	if inv.isPopulatedOutsideOneof(first) && inv.isPopulatedOutsideOneof(second) {
+		return Patch{{Op: OpSwap, Path: string(first), From: string(second)}}, nil
+	}
+	restoreFirst, err := inv.restore(first)
+	if err != nil {
+		return nil, err
+	}
+	restoreSecond, err := inv.restore(second)
+	if err != nil {
+		return nil, err
+	}
+	return append(restoreFirst, restoreSecond...), nil
+}
+
+// isPopulatedOutsideOneof reports whether the element pointed by the given path is not a message field, or is a populated message field that is not a member of a oneof.
+func (inv inverter) isPopulatedOutsideOneof(path Path) bool {
+	if path == "" {
+		return true
+	}
+	c, last, err := inv.parent(path)
+	if err != nil {
+		return false
+	}
+	mc, ok := unwrapContainer(c).(*messageContainer)
+	if !ok {
+		return true
+	}
+	field, err := fieldInMessage(mc.msg.Descriptor().Fields(), last.Value())
+	if err != nil {
+		return false
+	}
+	return field.ContainingOneof() == nil && mc.msg.Has(field)
+}
+
+// isKeyed reports whether the element pointed by the given path is a message field or a map item.
+func (inv inverter) isKeyed(path Path) bool {
+	c, _, err := inv.parent(path)
+	if err != nil {
+		return false
+	}
+	switch unwrapContainer(c).(type) {
+	case *messageContainer, *mapContainer:
+		return true
+	}
+	return false
+}
+
+// isPathPrefix reports whether prefix path refers to the same element as the other path or to one of its ancestors.
+func isPathPrefix(prefix, other Path) bool {
+	ps, os := prefix.Segments(), other.Segments()
+	if len(ps) > len(os) {
+		return false
+	}
+	return slices.EqualFunc(ps, os[:len(ps)], func(x, y PathSegment) bool { return x.Value() == y.Value() })
+}
+
+// literalPath returns the path with wildcards and filters selecting all matching list items (segments that set, clear and append operations expand) escaped, so that they refer to keys as they do in operations that are not expanded.
+func literalPath(path Path) Path {
+	if !path.HasWildcard() {
+		return path
+	}
+	literal := Path("")
+	for ps := range path.Iter {
+		segment := ps.raw()
+		if segment == PathWildcard || isSelectingPathFilter(segment) {
+			segment = EscapePathSegment(ps.Value())
+		}
+		if ps.IsFirst() {
+			literal = Path(segment)
+		} else {
+			literal = literal.JoinSegmentValue(segment)
+		}
+	}
+	return literal
+}
+
+// siblingPath returns path to the element with the given key held by the same container as the given segment. The key is escaped when necessary.
+func siblingPath(ps PathSegment, key string) Path {
+	if ps.IsFirst() {
+		return NewPath(key)
+	}
+	return ps.PrecedingPath().JoinSegmentValue(EscapePathSegment(key))
+}
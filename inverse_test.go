@@ -0,0 +1,259 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestApplyWithInverse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		base  proto.Message
+		patch protopatch.Patch
+		opts  []protopatch.Option
+		want  protopatch.Patch // nil means that only round trip is checked
+	}{
+		{
+			name: "set",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+				{Op: protopatch.OpSet, Path: "int32", Value: int32(1)},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "int32"},
+				{Op: protopatch.OpSet, Path: "string", Value: "aaa"},
+			},
+		},
+		{
+			name: "list",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpAppend, Path: "list.string", Value: "d"},
+				{Op: protopatch.OpInsert, Path: "list.string.-1", Value: "e"},
+				{Op: protopatch.OpClear, Path: "list.string.1"},
+				{Op: protopatch.OpSet, Path: "list.string.-1", Value: "f"},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.string.-1", Value: "e"},
+				{Op: protopatch.OpInsert, Path: "list.string.1", Value: "b"},
+				{Op: protopatch.OpClear, Path: "list.string.4"},
+				{Op: protopatch.OpClear, Path: "list.string.3"},
+			},
+		},
+		{
+			name: "map",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a": "a", "b": "b"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "map.stringToString.c", Value: "c"},
+				{Op: protopatch.OpClear, Path: "map.stringToString.a"},
+				{Op: protopatch.OpMove, Path: "map.stringToString.d", From: "map.stringToString.b"},
+				{Op: protopatch.OpMove, Path: "map.stringToString.c", From: "map.stringToString.d"},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "map.stringToString.d", From: "map.stringToString.c"},
+				{Op: protopatch.OpSet, Path: "map.stringToString.c", Value: "c"},
+				{Op: protopatch.OpMove, Path: "map.stringToString.b", From: "map.stringToString.d"},
+				{Op: protopatch.OpSet, Path: "map.stringToString.a", Value: "a"},
+				{Op: protopatch.OpClear, Path: "map.stringToString.c"},
+			},
+		},
+		{
+			name: "move",
+			base: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "bbb"}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "message.string", From: "string"},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "string", From: "message.string"},
+				{Op: protopatch.OpSet, Path: "message.string", Value: "bbb"},
+			},
+		},
+		{
+			name: "move-list-items",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b"}, {String_: "c"}}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "list.message.2", From: "list.message.0"},
+				{Op: protopatch.OpMove, Path: "list.message.0", From: "list.message.1"},
+			},
+		},
+		{
+			name: "move-message-into-itself",
+			base: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "bbb"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "message", From: "message.message"},
+			},
+		},
+		{
+			name: "swap-and-copy",
+			base: &protopatchv1.TestMessage{String_: "aaa", List: &protopatchv1.TestList{String_: []string{"a", "b"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSwap, Path: "list.string.0", From: "list.string.1"},
+				{Op: protopatch.OpCopy, Path: "list.string.1", From: "string"},
+			},
+		},
+		{
+			name: "swap-with-oneof-member",
+			base: &protopatchv1.TestMessage{String_: "x", Oneof: &protopatchv1.TestOneof{Types: &protopatchv1.TestOneof_Int32{Int32: 5}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSwap, Path: "oneof.string", From: "string"},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "oneof.int32", Value: int32(5)},
+				{Op: protopatch.OpSet, Path: "string", Value: "x"},
+			},
+		},
+		{
+			name: "swap-with-unset-fields",
+			base: &protopatchv1.TestMessage{String_: "x", Int32: 3, Message: &protopatchv1.TestMessage{Int32: 1}, Oneof: &protopatchv1.TestOneof{}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSwap, Path: "string", From: "message.string"},
+				{Op: protopatch.OpSwap, Path: "oneof.int32", From: "int32"},
+			},
+		},
+		{
+			name: "swap-populated-fields",
+			base: &protopatchv1.TestMessage{String_: "x", Message: &protopatchv1.TestMessage{String_: "y"}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSwap, Path: "string", From: "message.string"},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpSwap, Path: "string", From: "message.string"},
+			},
+		},
+		{
+			name: "move-to-filter-like-map-key",
+			base: &protopatchv1.TestMessage{String_: "new", Map: &protopatchv1.TestMap{StringToString: map[string]string{"[?(@==x)]": "old"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "map.stringToString.[?(@==x)]", From: "string"},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "string", From: `map.stringToString.\[?(@==x)\]`},
+				{Op: protopatch.OpSet, Path: `map.stringToString.\[?(@==x)\]`, Value: "old"},
+			},
+		},
+		{
+			name: "move-to-escaped-wildcard-map-key",
+			base: &protopatchv1.TestMessage{String_: "new", Map: &protopatchv1.TestMap{StringToString: map[string]string{"*": "old", "a": "a"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: `map.stringToString.\*`, From: "string"},
+				{Op: protopatch.OpCopy, Path: "string", From: `map.stringToString["*"]`},
+			},
+		},
+		{
+			name: "oneof",
+			base: &protopatchv1.TestMessage{Oneof: &protopatchv1.TestOneof{Types: &protopatchv1.TestOneof_String_{String_: "aaa"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "oneof.int32", Value: int32(1)},
+				{Op: protopatch.OpSet, Path: "oneof.message", Value: &protopatchv1.TestMessage{String_: "bbb"}},
+			},
+			want: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "oneof.int32", Value: int32(1)},
+				{Op: protopatch.OpSet, Path: "oneof.string", Value: "aaa"},
+			},
+		},
+		{
+			name: "messages",
+			base: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "bbb"}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "message", Value: &protopatchv1.TestMessage{Int32: 1}},
+				{Op: protopatch.OpSet, Path: "list", Value: &protopatchv1.TestList{String_: []string{"a"}}},
+				{Op: protopatch.OpClear, Path: ""},
+			},
+		},
+		{
+			name: "transformed-container",
+			base: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"key0": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+						"key1": structpb.NewStringValue("aaa"),
+					}}),
+				}}),
+			}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "wellKnown.value.key0.key1", Value: structpb.NewStringValue("bbb")},
+				{Op: protopatch.OpSet, Path: "wellKnown.value.key0.key2", Value: structpb.NewStringValue("ccc")},
+			},
+			opts: []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			want := proto.Clone(test.base)
+			require.NoError(t, protopatch.Apply(want, test.patch, test.opts...))
+
+			got := proto.Clone(test.base)
+			inverse, err := protopatch.ApplyWithInverse(got, test.patch, test.opts...)
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, want, got, "message after apply mismatch")
+			if test.want != nil {
+				require.Equal(t, len(test.want), len(inverse), "inverse patch length mismatch: %v", inverse)
+				for i := range test.want {
+					require.Equal(t, test.want[i].Op, inverse[i].Op, "operation %d kind mismatch", i)
+					require.Equal(t, test.want[i].Path, inverse[i].Path, "operation %d path mismatch", i)
+					require.Equal(t, test.want[i].From, inverse[i].From, "operation %d from mismatch", i)
+					patchtest.RequireEqual(t, test.want[i].Value, inverse[i].Value, "operation %d value mismatch", i)
+				}
+			}
+
+			require.NoError(t, protopatch.Apply(got, inverse, test.opts...))
+			patchtest.RequireEqual(t, test.base, got, "message after applying inverse mismatch")
+		})
+	}
+}
+
+func TestApplyWithInverseFailure(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestMessage{String_: "aaa"}
+	patch := protopatch.Patch{
+		{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+		{Op: protopatch.OpSet, Path: "unknown", Value: "ccc"},
+	}
+
+	got := proto.Clone(base)
+	inverse, err := protopatch.ApplyWithInverse(got, patch)
+	require.Error(t, err)
+	require.Len(t, inverse, 1)
+	require.NoError(t, protopatch.Apply(got, inverse))
+	patchtest.RequireEqual(t, base, got, "message after applying inverse mismatch")
+
+	got = proto.Clone(base)
+	inverse, err = protopatch.ApplyWithInverse(got, patch, protopatch.WithRollback())
+	require.Error(t, err)
+	require.Empty(t, inverse)
+	patchtest.RequireEqual(t, base, got, "message after rollback mismatch")
+
+	// operations whose inverse cannot be computed fail the same way as when applied without computing the inverse
+	for _, op := range []protopatch.Operation{
+		{Op: protopatch.OpClear, Path: "list.string.5"},
+		{Op: protopatch.OpInsert, Path: "list.string.5", Value: "ccc"},
+		{Op: protopatch.OpSet, Path: "list.string.[.=x]", Value: "ccc"},
+		{Op: protopatch.OpMove, Path: "string", From: "map.stringToString.unknown"},
+		{Op: protopatch.OpMove, Path: "map.stringToString.*", From: "list.string.0"},
+	} {
+		base := &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a"}}, Map: &protopatchv1.TestMap{}}
+		patch := protopatch.Patch{{Op: protopatch.OpSet, Path: "string", Value: "bbb"}, op}
+		want := protopatch.Apply(proto.Clone(base), patch)
+		require.Error(t, want)
+
+		got := proto.Clone(base)
+		inverse, err := protopatch.ApplyWithInverse(got, patch)
+		require.Equal(t, want, err, "operation %v", op)
+		require.Len(t, inverse, 1)
+		require.NoError(t, protopatch.Apply(got, inverse))
+		patchtest.RequireEqual(t, base, got, "message after applying inverse mismatch")
+	}
+}
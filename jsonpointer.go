@@ -0,0 +1,73 @@
+package protopatch
+
+import (
+	"strings"
+)
+
+// PathEndOfList is a path segment referring to the position just after the last list item, as the "-" token of JSON Pointer (RFC 6901). Insert operation with this segment as the last one appends the value to the list. Other operations fail, as the position holds no item.
+const PathEndOfList = "-"
+
+// ParseJSONPointer converts JSON Pointer (RFC 6901) into Path. Reference tokens are unescaped ("~1" into "/" and "~0" into "~") and then escaped with EscapePathSegment, so they always refer to a single field, list index or map key (in particular JSON Pointer has no notion of wildcards and filters). An empty pointer refers to the base message and is converted into an empty path. It returns ErrInvalidJSONPointer error when the pointer is malformed.
+func ParseJSONPointer(pointer string) (Path, error) {
+	if pointer == "" {
+		return "", nil
+	}
+	if pointer[0] != '/' {
+		return "", ErrInvalidJSONPointer
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		t, err := unescapeJSONPointerToken(t)
+		if err != nil {
+			return "", err
+		}
+		tokens[i] = t
+	}
+	return NewPath(tokens...), nil
+}
+
+// JSONPointer converts the path into JSON Pointer (RFC 6901). Keys of path segments are escaped as JSON Pointer reference tokens. Wildcards and filters are not expressible in JSON Pointer and are converted as they are written, so they become plain reference tokens.
+func (p Path) JSONPointer() string {
+	if p == "" {
+		return ""
+	}
+	b := strings.Builder{}
+	for ps := range p.Iter {
+		key := ps.Value()
+		if ps.raw() == PathWildcard || ps.IsFilter() {
+			key = ps.raw()
+		}
+		b.WriteByte('/')
+		b.WriteString(escapeJSONPointerToken(key))
+	}
+	return b.String()
+}
+
+var jsonPointerTokenEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func escapeJSONPointerToken(t string) string {
+	return jsonPointerTokenEscaper.Replace(t)
+}
+
+func unescapeJSONPointerToken(t string) (string, error) {
+	if !strings.Contains(t, "~") {
+		return t, nil
+	}
+	b := strings.Builder{}
+	for i := 0; i < len(t); i++ {
+		if t[i] != '~' {
+			b.WriteByte(t[i])
+			continue
+		}
+		if i+1 == len(t) || (t[i+1] != '0' && t[i+1] != '1') {
+			return "", ErrInvalidJSONPointer
+		}
+		if t[i+1] == '0' {
+			b.WriteByte('~')
+		} else {
+			b.WriteByte('/')
+		}
+		i++
+	}
+	return b.String(), nil
+}
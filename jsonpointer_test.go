@@ -0,0 +1,111 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestJSONPointer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pointer string
+		want    protopatch.Path
+		wantErr error
+	}{
+		{
+			name:    "empty",
+			pointer: "",
+			want:    "",
+		},
+		{
+			name:    "plain-tokens",
+			pointer: "/list/message/0/string",
+			want:    "list.message.0.string",
+		},
+		{
+			name:    "escaped-tokens",
+			pointer: "/map/stringToString/a~1b~0c",
+			want:    "map.stringToString.a/b~c",
+		},
+		{
+			name:    "special-characters",
+			pointer: "/map/stringToString/example.com/*/[d]",
+			want:    `map.stringToString.example\.com.\*.\[d\]`,
+		},
+		{
+			name:    "empty-token",
+			pointer: "/map/stringToString/",
+			want:    `map.stringToString.[""]`,
+		},
+		{
+			name:    "end-of-list",
+			pointer: "/list/string/-",
+			want:    "list.string.-",
+		},
+		{
+			name:    "missing-leading-slash",
+			pointer: "list/string",
+			wantErr: protopatch.ErrInvalidJSONPointer,
+		},
+		{
+			name:    "invalid-escape",
+			pointer: "/map/stringToString/a~2",
+			wantErr: protopatch.ErrInvalidJSONPointer,
+		},
+		{
+			name:    "trailing-tilde",
+			pointer: "/map/stringToString/a~",
+			wantErr: protopatch.ErrInvalidJSONPointer,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := protopatch.ParseJSONPointer(test.pointer)
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+			require.Equal(t, test.pointer, got.JSONPointer())
+		})
+	}
+}
+
+func TestPathJSONPointer(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "/list/message/*/string", protopatch.Path("list.message.*.string").JSONPointer())
+	require.Equal(t, "/list/message/[string=a~1b]", protopatch.Path("list.message[string=a/b]").JSONPointer())
+	require.Equal(t, "/map/stringToString/a.b", protopatch.Path(`map.stringToString["a.b"]`).JSONPointer())
+}
+
+func TestJSONPointerEndOfList(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b"}}}
+	path, err := protopatch.ParseJSONPointer("/list/string/-")
+	require.NoError(t, err)
+
+	got := proto.Clone(base)
+	require.NoError(t, protopatch.Insert(got, string(path), "c"))
+	patchtest.RequireEqual(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c"}}}, got, "message after insert mismatch")
+
+	got = proto.Clone(base)
+	inverse, err := protopatch.ApplyWithInverse(got, protopatch.Patch{{Op: protopatch.OpInsert, Path: string(path), Value: "c"}})
+	require.NoError(t, err)
+	require.NoError(t, protopatch.Apply(got, inverse))
+	patchtest.RequireEqual(t, base, got, "message after applying inverse mismatch")
+
+	require.Error(t, protopatch.Set(proto.Clone(base), string(path), "c"))
+}
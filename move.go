@@ -7,15 +7,27 @@ func Move(base proto.Message, targetPath, replacementPath string, opts ...Option
 }
 
 func moveWithSetup(base proto.Message, targetPath, replacementPath string, setup *setup) error {
+	if err := checkNoWildcard(targetPath, OpMove); err != nil {
+		return err
+	}
+	if err := checkNoWildcard(replacementPath, OpMove); err != nil {
+		return err
+	}
+	if err := checkPath(base, targetPath, setup); err != nil {
+		return err
+	}
+	if err := checkPath(base, replacementPath, setup); err != nil {
+		return err
+	}
 	if targetPath == replacementPath { // set value pointed by path to itself
 		return setToItself(base, targetPath, setup)
 	}
 
-	replacementValue, replacementSet, err := getCopyAndSetter(base, replacementPath, setup)
+	replacementValue, replacementSet, err := getCopyAndSetter(base, replacementPath, false, setup)
 	if err != nil {
 		return err
 	}
-	targetValue, targetSet, err := getCopyAndSetter(base, targetPath, setup) // TODO: Create and use getAndSetter function to avoid copying target
+	targetValue, targetSet, err := getCopyAndSetter(base, targetPath, true, setup) // target may be a map key that does not exist yet; TODO: Create and use getAndSetter function to avoid copying target
 	if err != nil {
 		return err
 	}
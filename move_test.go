@@ -60,6 +60,27 @@ func TestMove(t *testing.T) {
 			replacementPath: "stringToString.key1",
 			want:            &protopatchv1.TestMap{StringToString: map[string]string{"key0": "bbb"}},
 		},
+		{
+			name:            "scalar-map/new-item/from/scalar-map/item",
+			base:            &protopatchv1.TestMap{StringToString: map[string]string{"key1": "bbb"}},
+			targetPath:      "stringToString.key0",
+			replacementPath: "stringToString.key1",
+			want:            &protopatchv1.TestMap{StringToString: map[string]string{"key0": "bbb"}},
+		},
+		{
+			name:            "scalar-map/item/from/scalar-map/missing-item",
+			base:            &protopatchv1.TestMap{StringToString: map[string]string{"key0": "aaa"}},
+			targetPath:      "stringToString.key0",
+			replacementPath: "stringToString.key1",
+			wantErr:         protopatch.NewErrInPath("stringToString", protopatch.ErrNotFound{Kind: "key", Value: "key1"}),
+		},
+		{
+			name:            "message/from/unset-message/scalar-map/missing-item",
+			base:            &protopatchv1.TestMessage{Int32: 1},
+			targetPath:      "map",
+			replacementPath: "map.int32ToString.2",
+			wantErr:         protopatch.NewErrInPath("map.int32ToString", protopatch.ErrNotFound{Kind: "key", Value: "2"}),
+		},
 
 		{
 			name:            "base/from/message",
@@ -0,0 +1,142 @@
+package protopatch
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// OpKind identifies the kind of a patch operation.
+type OpKind string
+
+const (
+	OpSet    OpKind = "set"
+	OpAppend OpKind = "append"
+	OpInsert OpKind = "insert"
+	OpClear  OpKind = "clear"
+	OpCopy   OpKind = "copy"
+	OpMove   OpKind = "move"
+	OpSwap   OpKind = "swap"
+	OpTest   OpKind = "test"
+)
+
+// Operation is a single step of a patch document.
+type Operation struct {
+	// Op is the kind of the operation.
+	Op OpKind
+
+	// Path is the path of the target element. For swap operation it is the first element.
+	Path string
+
+	// From is the path of the replacement element for copy and move operations and the path of the second element for swap operation. It is ignored by other operations.
+	From string
+
+	// Value is the replacement value for set operation, the new value for append and insert operations and the expected value for test operation. It is ignored by other operations.
+	Value any
+}
+
+// Patch is an ordered list of operations applied one after another.
+type Patch []Operation
+
+// OperationResolver represents an entity that can replace operations depending on the current state of the base message with concrete operations, for example an operation that inserts into lists and sets all other elements.
+type OperationResolver interface {
+	// ResolveOperation returns the operation to be applied in place of the provided one. It is called right before the operation is applied, so the base message reflects all preceding operations of the patch. Operations that the resolver does not handle should be returned unchanged.
+	ResolveOperation(base proto.Message, op Operation) (Operation, error)
+}
+
+// OperationResolverFunc allows to implement OperationResolver interface with a function.
+type OperationResolverFunc func(base proto.Message, op Operation) (Operation, error)
+
+func (fn OperationResolverFunc) ResolveOperation(base proto.Message, op Operation) (Operation, error) {
+	return fn(base, op)
+}
+
+// WithOperationResolution returns option that makes Apply pass every operation through the provided resolvers, in order, before the operation is applied. Errors returned by resolvers fail the operation like any other error. Rollback and inverse patches (see WithRollback and ApplyWithInverse) are computed for resolved operations.
+func WithOperationResolution(resolvers ...OperationResolver) Option {
+	return optionFunc(func(s *setup) {
+		s.resolve = resolvers // TODO: Copy slice to avoid referencing the passed value (?)
+	})
+}
+
+// WithAdditionalOperationResolution adds the provided resolvers after the already configured ones (see WithOperationResolution), so that they resolve operations already resolved by the resolvers configured earlier.
+func WithAdditionalOperationResolution(resolvers ...OperationResolver) Option {
+	return optionFunc(func(s *setup) {
+		s.resolve = append(s.resolve[:len(s.resolve):len(s.resolve)], resolvers...)
+	})
+}
+
+// Apply applies all operations of the provided patch to the base message in order. It stops at the first failing operation and returns ErrInOperation error describing it. Operations applied before the failing one are not reverted, unless WithRollback option is provided.
+func Apply(base proto.Message, patch Patch, opts ...Option) error {
+	return applyWithSetup(base, patch, newSetup(opts...), nil)
+}
+
+// applyWithSetup applies the patch. When inverse is not nil, it is filled with the inverse of applied operations.
+func applyWithSetup(base proto.Message, patch Patch, setup *setup, inverse *Patch) error {
+	var undo undoLog
+	var inv *inverter
+	if inverse != nil {
+		inv = &inverter{base: base, setup: setup}
+	}
+	inverses := []Patch(nil)
+	for i, op := range patch {
+		ops, err := applyResolvedOperation(base, op, setup, &undo, inv)
+		if err != nil {
+			undo.rollback()
+			if !setup.rollback {
+				collectInverse(inverse, inverses)
+			}
+			return newErrInOperation(i, op, err)
+		}
+		inverses = append(inverses, ops)
+	}
+	collectInverse(inverse, inverses)
+	return nil
+}
+
+// applyResolvedOperation resolves the operation (see WithOperationResolution) and applies it. The resolved operation is recorded in the undo log when rollback is enabled. When inv is not nil, the inverse of the operation is computed before it is applied and returned.
+func applyResolvedOperation(base proto.Message, op Operation, setup *setup, undo *undoLog, inv *inverter) (Patch, error) {
+	op, err := setup.ResolveOperation(base, op)
+	if err != nil {
+		return nil, err
+	}
+	if setup.rollback {
+		undo.recordOperation(base, op, setup)
+	}
+	inverse := Patch(nil)
+	if inv != nil {
+		if inverse, err = inv.operation(op); err != nil {
+			return nil, err
+		}
+	}
+	return inverse, applyOperation(base, op, setup)
+}
+
+// collectInverse stores inverse operations, in reverse order of the original operations.
+func collectInverse(inverse *Patch, inverses []Patch) {
+	if inverse == nil {
+		return
+	}
+	for i := len(inverses) - 1; i >= 0; i-- {
+		*inverse = append(*inverse, inverses[i]...)
+	}
+}
+
+func applyOperation(base proto.Message, op Operation, setup *setup) error {
+	switch op.Op {
+	case OpSet:
+		return setWithSetup(base, op.Path, op.Value, setup)
+	case OpAppend:
+		return appendWithSetup(base, op.Path, op.Value, setup)
+	case OpInsert:
+		return insertWithSetup(base, op.Path, op.Value, setup)
+	case OpClear:
+		return clearWithSetup(base, op.Path, setup)
+	case OpCopy:
+		return copyWithSetup(base, op.Path, op.From, setup)
+	case OpMove:
+		return moveWithSetup(base, op.Path, op.From, setup)
+	case OpSwap:
+		return swapWithSetup(base, op.Path, op.From, setup)
+	case OpTest:
+		return testWithSetup(base, op.Path, op.Value, setup)
+	}
+	return ErrUnknownOperation
+}
@@ -0,0 +1,185 @@
+package protopatch_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		base    proto.Message
+		patch   protopatch.Patch
+		opts    []protopatch.Option
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name:  "empty",
+			base:  &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{},
+			want:  &protopatchv1.TestMessage{String_: "aaa"},
+		},
+		{
+			name: "sequence",
+			base: &protopatchv1.TestMessage{
+				String_: "aaa",
+				Message: &protopatchv1.TestMessage{String_: "bbb"},
+				List:    &protopatchv1.TestList{String_: []string{"x", "y"}},
+			},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "ccc"},
+				{Op: protopatch.OpAppend, Path: "list.string", Value: "z"},
+				{Op: protopatch.OpInsert, Path: "list.string.0", Value: "w"},
+				{Op: protopatch.OpCopy, Path: "message.string", From: "string"},
+				{Op: protopatch.OpSwap, Path: "list.string.0", From: "list.string.3"},
+				{Op: protopatch.OpMove, Path: "message.string", From: "string"},
+				{Op: protopatch.OpClear, Path: "list.string.-1"},
+			},
+			want: &protopatchv1.TestMessage{
+				Message: &protopatchv1.TestMessage{String_: "ccc"},
+				List:    &protopatchv1.TestList{String_: []string{"z", "x", "y"}},
+			},
+		},
+		{
+			name: "clear-list-items",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"a", "b", "c", "d"},
+				Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b"}, {String_: "c"}},
+			}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.string.1"},
+				{Op: protopatch.OpClear, Path: "list.string.0"},
+				{Op: protopatch.OpClear, Path: "list.message.0"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{
+				String_: []string{"c", "d"},
+				Message: []*protopatchv1.TestMessage{{String_: "b"}, {String_: "c"}},
+			}},
+		},
+		{
+			name: "set-nil-clears",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: nil},
+			},
+			want: &protopatchv1.TestMessage{},
+		},
+		{
+			name: "failure-in-path",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+				{Op: protopatch.OpSet, Path: "message.unknown", Value: "bbb"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 1, Op: protopatch.OpSet, Cause: protopatch.NewErrInPath("message", protopatch.ErrNotFound{Kind: "field", Value: "unknown"})},
+		},
+		{
+			name: "failure-in-single-segment-path",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "unknown"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpClear, Cause: protopatch.NewErrInPath("unknown", protopatch.ErrNotFound{Kind: "field", Value: "unknown"})},
+		},
+		{
+			name: "escaped-map-keys",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"example.com": "a"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: `map.stringToString["example.com"]`, Value: "b"},
+				{Op: protopatch.OpMove, Path: `map.stringToString.a\.b`, From: `map.stringToString.example\.com`},
+				{Op: protopatch.OpSet, Path: string(protopatch.NewPath("map", "stringToString", "")), Value: "c"},
+			},
+			want: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a.b": "b", "": "c"}}},
+		},
+		{
+			name: "test",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpTest, Path: "string", Value: "aaa"},
+				{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+				{Op: protopatch.OpTest, Path: "string", Value: "bbb"},
+			},
+			want: &protopatchv1.TestMessage{String_: "bbb"},
+		},
+		{
+			name: "test-failure",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+				{Op: protopatch.OpTest, Path: "string", Value: "aaa"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 1, Op: protopatch.OpTest, Cause: protopatch.NewErrInPath("string", protopatch.ErrTestFailed{Expected: "aaa", Actual: "bbb"})},
+		},
+		{
+			name: "unknown-operation",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+				{Op: "unknown", Path: "string"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 1, Op: "unknown", Cause: protopatch.ErrUnknownOperation},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			base := proto.Clone(test.base)
+			err := protopatch.Apply(base, test.patch, test.opts...)
+
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, base, "message after apply mismatch")
+		})
+	}
+}
+
+func TestWithOperationResolution(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd value")
+	double := protopatch.WithOperationResolution(protopatch.OperationResolverFunc(func(base proto.Message, op protopatch.Operation) (protopatch.Operation, error) {
+		if op.Op != "double" {
+			return op, nil
+		}
+		v, err := protopatch.GetAs[int32](base, op.Path)
+		if err != nil {
+			return op, err
+		}
+		if v%2 != 0 {
+			return op, errOdd
+		}
+		return protopatch.Operation{Op: protopatch.OpSet, Path: op.Path, Value: 2 * v}, nil
+	}))
+
+	base := &protopatchv1.TestMessage{Int32: 2}
+	inverse, err := protopatch.ApplyWithInverse(base, protopatch.Patch{
+		{Op: "double", Path: "int32"},
+		{Op: protopatch.OpSet, Path: "string", Value: "aaa"},
+		{Op: "double", Path: "int32"},
+	}, double)
+	require.NoError(t, err)
+	patchtest.RequireEqual(t, &protopatchv1.TestMessage{Int32: 8, String_: "aaa"}, base, "message after apply mismatch")
+	require.NoError(t, protopatch.Apply(base, inverse))
+	patchtest.RequireEqual(t, &protopatchv1.TestMessage{Int32: 2}, base, "message after applying inverse mismatch")
+
+	err = protopatch.Apply(base, protopatch.Patch{
+		{Op: protopatch.OpSet, Path: "int32", Value: int32(3)},
+		{Op: "double", Path: "int32"},
+	}, double, protopatch.WithRollback())
+	require.Equal(t, protopatch.ErrInOperation{Index: 1, Op: "double", Cause: protopatch.NewErrInPath("int32", errOdd)}, err)
+	patchtest.RequireEqual(t, &protopatchv1.TestMessage{Int32: 2}, base, "message after failed apply mismatch")
+}
@@ -0,0 +1,384 @@
+package patchanypb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/daishe/protopatch"
+)
+
+// TypeKey is a pseudo key of google.protobuf.Any containers holding type URL of the packed message. Setting it to a different type URL replaces the packed message with an empty message of the new type and clearing it clears the whole google.protobuf.Any message.
+const TypeKey = "@type"
+
+// google.protobuf.Any message and its fields (see google/protobuf/any.proto). Fields are accessed by their numbers, so that dynamic google.protobuf.Any messages are supported as well.
+const (
+	anyFullName      protoreflect.FullName    = "google.protobuf.Any"
+	anyTypeURLNumber protoreflect.FieldNumber = 1
+	anyValueNumber   protoreflect.FieldNumber = 2
+)
+
+// ErrUnknownType is returned when the type of the packed message cannot be resolved.
+type ErrUnknownType struct {
+	URL string
+}
+
+func (e ErrUnknownType) Error() string {
+	return fmt.Sprintf("cannot resolve packed message type %q", e.URL)
+}
+
+// AnyContainerTransformer returns container transformer that allows to descend into messages packed within google.protobuf.Any messages (including dynamic ones). Packed message is unpacked with the configured resolver (see WithResolver) and packed again after every modification of it. Recently unpacked messages are reused by following operations, as long as the google.protobuf.Any message holds the same type URL and encoded value (modifying the encoded value in place is not detected).
+//
+// Containers of values within the packed message are re-packed only as long as they are not replaced by other transformers, so values within the packed message requiring other transformations (for example google.protobuf.Struct values) can be read, but not modified. Values within the packed message cannot be obtained with Mutable method of containers either (ErrMutationOfReadOnlyValue error is returned), as their later modifications could not be re-packed.
+func AnyContainerTransformer(opts ...Option) protopatch.ContainerTransformer {
+	setup := newSetup(opts...)
+	return protopatch.ContainerTransformerFunc(func(container protopatch.Container) (protopatch.Container, error) {
+		return containerTransform(container, setup)
+	})
+}
+
+func AnyContainerTransform(container protopatch.Container, opts ...Option) (protopatch.Container, error) {
+	return containerTransform(container, newSetup(opts...))
+}
+
+func containerTransform(container protopatch.Container, setup *setup) (protopatch.Container, error) {
+	m, ok := container.Self().(proto.Message)
+	if !ok || m.ProtoReflect().Descriptor().FullName() != anyFullName {
+		return nil, protopatch.ErrNoContainerTransformationDefined
+	}
+	c := &anyContainer{self: m, setup: setup}
+	if container.IsReadOnly() {
+		c.self = m.ProtoReflect().Type().Zero().Interface()
+	} else {
+		c.any = m.ProtoReflect()
+	}
+	if p, ok := container.(interface{ parentRepack() func() error }); ok {
+		c.parent = p.parentRepack()
+	}
+	if err := c.unpack(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+type anyContainer struct {
+	any    protoreflect.Message // google.protobuf.Any message; nil when read-only
+	self   proto.Message        // value returned by Self
+	setup  *setup
+	msg    proto.Message        // unpacked message; nil when type URL is not set
+	inner  protopatch.Container // container of the unpacked message
+	err    error                // error returned for all accesses to the unpacked message
+	parent func() error         // re-packs the message holding this google.protobuf.Any message, if it is packed as well
+}
+
+func (c *anyContainer) field(num protoreflect.FieldNumber) protoreflect.FieldDescriptor {
+	return c.any.Descriptor().Fields().ByNumber(num)
+}
+
+func (c *anyContainer) typeURL() string {
+	if c.any == nil {
+		return ""
+	}
+	return c.any.Get(c.field(anyTypeURLNumber)).String()
+}
+
+func (c *anyContainer) value() []byte {
+	if c.any == nil {
+		return nil
+	}
+	return c.any.Get(c.field(anyValueNumber)).Bytes()
+}
+
+func (c *anyContainer) unpack() error {
+	c.msg, c.inner, c.err = nil, nil, nil
+	url := c.typeURL()
+	if url == "" {
+		return nil
+	}
+	value := c.value()
+	if msg := c.setup.cache.load(c.any, url, value); msg != nil {
+		c.msg, c.inner = msg, protopatch.MessageContainer(msg)
+		return nil
+	}
+	mt, err := c.setup.resolver.FindMessageByURL(url)
+	if err == protoregistry.NotFound {
+		c.err = ErrUnknownType{URL: url}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return err
+	}
+	c.setup.cache.store(c.any, url, value, msg)
+	c.msg, c.inner = msg, protopatch.MessageContainer(msg)
+	return nil
+}
+
+func (c *anyContainer) repack() error {
+	if c.msg != nil {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(c.msg)
+		if err != nil {
+			return err
+		}
+		c.any.Set(c.field(anyValueNumber), protoreflect.ValueOfBytes(b))
+		c.setup.cache.store(c.any, c.typeURL(), c.value(), c.msg)
+	}
+	if c.parent != nil {
+		return c.parent()
+	}
+	return nil
+}
+
+// modify performs the given modification of the unpacked message (if any) and re-packs it. The unpacked message is not reused by other containers until it is re-packed, so that failed modifications are not observed by following operations.
+func (c *anyContainer) modify(fn func() error) error {
+	c.setup.cache.drop(c.any)
+	if fn != nil {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return c.repack()
+}
+
+func (c *anyContainer) payload(key string) (protopatch.Container, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.inner == nil {
+		return nil, protopatch.ErrNotFound{Kind: "field", Value: key}
+	}
+	return c.inner, nil
+}
+
+func (c *anyContainer) IsReadOnly() bool {
+	return c.any == nil
+}
+
+func (c *anyContainer) Self() any {
+	return c.self
+}
+
+func (c *anyContainer) Get(key string) (any, error) {
+	if key == TypeKey {
+		return c.typeURL(), nil
+	}
+	inner, err := c.payload(key)
+	if err != nil {
+		return nil, err
+	}
+	return inner.Get(key)
+}
+
+func (c *anyContainer) GetCopy(key string) (any, error) {
+	if key == TypeKey {
+		return c.typeURL(), nil
+	}
+	inner, err := c.payload(key)
+	if err != nil {
+		return nil, err
+	}
+	return inner.GetCopy(key)
+}
+
+func (c *anyContainer) GetNew(key string) (any, error) {
+	if key == TypeKey {
+		return "", nil
+	}
+	inner, err := c.payload(key)
+	if err != nil {
+		return nil, err
+	}
+	return inner.GetNew(key)
+}
+
+func (c *anyContainer) Mutable(key string) (any, error) {
+	if c.any == nil {
+		return nil, protopatch.ErrMutationOfReadOnlyValue
+	}
+	if key == TypeKey {
+		return c.typeURL(), nil
+	}
+	if _, err := c.payload(key); err != nil {
+		return nil, err
+	}
+	return nil, protopatch.ErrMutationOfReadOnlyValue // modifications of the returned value could not be re-packed
+}
+
+func (c *anyContainer) Access(key string) (protopatch.Container, error) {
+	if key == TypeKey {
+		return nil, protopatch.ErrAccessToNonContainer
+	}
+	inner, err := c.payload(key)
+	if err != nil {
+		return nil, err
+	}
+	next, err := inner.Access(key)
+	if err != nil {
+		return nil, err
+	}
+	return newPackedContainer(next, c.modify), nil
+}
+
+func (c *anyContainer) AccessMutable(key string) (protopatch.Container, error) {
+	if c.any == nil {
+		return nil, protopatch.ErrMutationOfReadOnlyValue
+	}
+	if key == TypeKey {
+		return nil, protopatch.ErrAccessToNonContainer
+	}
+	inner, err := c.payload(key)
+	if err != nil {
+		return nil, err
+	}
+	next := protopatch.Container(nil)
+	err = c.modify(func() error { // accessing may populate the accessed field
+		next, err = inner.AccessMutable(key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newPackedContainer(next, c.modify), nil
+}
+
+func (c *anyContainer) Set(key string, to any) error {
+	if c.any == nil {
+		return protopatch.ErrMutationOfReadOnlyValue
+	}
+	if key == TypeKey {
+		return c.setType(to)
+	}
+	inner, err := c.payload(key)
+	if err != nil {
+		return err
+	}
+	return c.modify(func() error {
+		return inner.Set(key, to)
+	})
+}
+
+func (c *anyContainer) setType(to any) error {
+	if to == nil {
+		c.setup.cache.drop(c.any)
+		c.any.Clear(c.field(anyTypeURLNumber))
+		c.any.Clear(c.field(anyValueNumber))
+		c.msg, c.inner, c.err = nil, nil, nil
+		return c.repack()
+	}
+	url, ok := to.(string)
+	if !ok {
+		return protopatch.NewErrInPath(TypeKey, protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType})
+	}
+	if url == c.typeURL() {
+		return nil
+	}
+	mt, err := c.setup.resolver.FindMessageByURL(url)
+	if err == protoregistry.NotFound {
+		return protopatch.NewErrInPath(TypeKey, ErrUnknownType{URL: url})
+	}
+	if err != nil {
+		return protopatch.NewErrInPath(TypeKey, err)
+	}
+	c.setup.cache.drop(c.any)
+	c.any.Set(c.field(anyTypeURLNumber), protoreflect.ValueOfString(url))
+	c.msg = mt.New().Interface()
+	c.inner, c.err = protopatch.MessageContainer(c.msg), nil
+	return c.repack()
+}
+
+func (c *anyContainer) Append(_ any) error {
+	if c.any == nil {
+		return protopatch.ErrMutationOfReadOnlyValue
+	}
+	return protopatch.ErrAppendToNonList
+}
+
+func (c *anyContainer) Insert(_ string, _ any) error {
+	if c.any == nil {
+		return protopatch.ErrMutationOfReadOnlyValue
+	}
+	return protopatch.ErrInsertToNonList
+}
+
+// packedContainer wraps container of a value within a packed message, so that the packed message is re-packed after every modification. It implements protopatch.WrappingContainer, so that filters and inverse patches handle values within packed messages like any other values.
+type packedContainer struct {
+	protopatch.Container
+	modify func(func() error) error
+}
+
+type packedKeysContainer struct {
+	*packedContainer
+}
+
+func (c packedKeysContainer) Keys() []string {
+	return c.Container.(protopatch.KeysContainer).Keys()
+}
+
+func newPackedContainer(c protopatch.Container, modify func(func() error) error) protopatch.Container {
+	pc := &packedContainer{Container: c, modify: modify}
+	if _, ok := c.(protopatch.KeysContainer); ok {
+		return packedKeysContainer{pc}
+	}
+	return pc
+}
+
+func (c *packedContainer) Unwrap() protopatch.Container {
+	return c.Container
+}
+
+func (c *packedContainer) parentRepack() func() error {
+	return func() error {
+		return c.modify(nil)
+	}
+}
+
+func (c *packedContainer) Mutable(key string) (any, error) {
+	if _, err := c.Container.Get(key); err != nil {
+		return nil, err
+	}
+	return nil, protopatch.ErrMutationOfReadOnlyValue // modifications of the returned value could not be re-packed
+}
+
+func (c *packedContainer) Access(key string) (protopatch.Container, error) {
+	next, err := c.Container.Access(key)
+	if err != nil {
+		return nil, err
+	}
+	return newPackedContainer(next, c.modify), nil
+}
+
+func (c *packedContainer) AccessMutable(key string) (protopatch.Container, error) {
+	next := protopatch.Container(nil)
+	err := c.modify(func() error { // accessing may populate the accessed field
+		var err error
+		next, err = c.Container.AccessMutable(key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newPackedContainer(next, c.modify), nil
+}
+
+func (c *packedContainer) Set(key string, to any) error {
+	return c.modify(func() error {
+		return c.Container.Set(key, to)
+	})
+}
+
+func (c *packedContainer) Append(new any) error {
+	return c.modify(func() error {
+		return c.Container.Append(new)
+	})
+}
+
+func (c *packedContainer) Insert(key string, new any) error {
+	return c.modify(func() error {
+		return c.Container.Insert(key, new)
+	})
+}
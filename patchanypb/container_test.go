@@ -0,0 +1,308 @@
+package patchanypb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchanypb"
+)
+
+func mustPack(t *testing.T, msg proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(msg)
+	require.NoError(t, err)
+	return a
+}
+
+func TestAnyContainerTransform(t *testing.T) {
+	t.Parallel()
+
+	messageURL := "type.googleapis.com/protopatch.v1.TestMessage"
+	tests := []struct {
+		name    string
+		given   func(t *testing.T) proto.Message
+		patch   protopatch.Patch
+		want    func(t *testing.T) proto.Message
+		wantErr error
+	}{
+		{
+			name: "set-field",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpSet, Path: "any.string", Value: "b"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "b"})}
+			},
+		},
+		{
+			name: "set-nested-field",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a", Message: &protopatchv1.TestMessage{}})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpSet, Path: "any.message.string", Value: "b"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a", Message: &protopatchv1.TestMessage{String_: "b"}})}
+			},
+		},
+		{
+			name: "append-to-list",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a"}}})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpAppend, Path: "any.list.string", Value: "b"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b"}}})}
+			},
+		},
+		{
+			name: "set-wildcard",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b"}}})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpSet, Path: "any.list.string.*", Value: "c"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"c", "c"}}})}
+			},
+		},
+		{
+			name: "set-field-in-nested-any",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpSet, Path: "any.wellKnown.any.string", Value: "b"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "b"})}})}
+			},
+		},
+		{
+			name: "test-field",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpTest, Path: "any.string", Value: "a"}, {Op: protopatch.OpTest, Path: "any.@type", Value: messageURL}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+		},
+		{
+			name: "copy-out-of-any",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "a"}})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpCopy, Path: "any.message.message", From: "any.message"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "a", Message: &protopatchv1.TestMessage{String_: "a"}}})}
+			},
+		},
+		{
+			name: "set-selecting-filter",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{Int32: []int32{1, 2, 1}}})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpClear, Path: "any.list.int32[?(@==1)]"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{Int32: []int32{2}}})}
+			},
+		},
+		{
+			name: "set-type",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "any.@type", Value: "type.googleapis.com/protopatch.v1.TestList"},
+				{Op: protopatch.OpAppend, Path: "any.string", Value: "b"},
+			},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestList{String_: []string{"b"}})}
+			},
+		},
+		{
+			name: "set-same-type",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpSet, Path: "any.@type", Value: messageURL}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+		},
+		{
+			name: "set-type-of-empty-any",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: &anypb.Any{}}
+			},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "any.@type", Value: messageURL},
+				{Op: protopatch.OpSet, Path: "any.int32", Value: int32(1)},
+			},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{Int32: 1})}
+			},
+		},
+		{
+			name: "clear-type",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+			patch: protopatch.Patch{{Op: protopatch.OpClear, Path: "any.@type"}},
+			want: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: &anypb.Any{}}
+			},
+		},
+		{
+			name: "set-unknown-type",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+			},
+			patch:   protopatch.Patch{{Op: protopatch.OpSet, Path: "any.@type", Value: "type.googleapis.com/unknown.Message"}},
+			wantErr: protopatch.NewErrInPath("any.@type", patchanypb.ErrUnknownType{URL: "type.googleapis.com/unknown.Message"}),
+		},
+		{
+			name: "access-unknown-type",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{Any: &anypb.Any{TypeUrl: "type.googleapis.com/unknown.Message"}}
+			},
+			patch:   protopatch.Patch{{Op: protopatch.OpSet, Path: "any.string", Value: "a"}},
+			wantErr: protopatch.NewErrInPath("any", patchanypb.ErrUnknownType{URL: "type.googleapis.com/unknown.Message"}),
+		},
+		{
+			name: "set-field-of-unset-any",
+			given: func(t *testing.T) proto.Message {
+				return &protopatchv1.TestWellKnown{}
+			},
+			patch:   protopatch.Patch{{Op: protopatch.OpSet, Path: "any.string", Value: "a"}},
+			wantErr: protopatch.NewErrInPath("any", protopatch.ErrNotFound{Kind: "field", Value: "string"}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := test.given(t)
+			err := protopatch.Apply(msg, test.patch, protopatch.WithContainerTransformation(patchanypb.AnyContainerTransformer()))
+			if test.wantErr != nil {
+				require.Equal(t, protopatch.ErrInOperation{Index: len(test.patch) - 1, Op: test.patch[len(test.patch)-1].Op, Cause: test.wantErr}, err)
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want(t), msg, "patched message differs")
+		})
+	}
+}
+
+func TestAnyContainerTransformGet(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{
+		Message:   &protopatchv1.TestMessage{String_: "a"},
+		WellKnown: &protopatchv1.TestWellKnown{Value: structpb.NewStringValue("b")},
+	})}
+	opt := protopatch.WithContainerTransformation(patchanypb.AnyContainerTransformer())
+
+	got, err := protopatch.Get(msg, "any.message.string", opt)
+	require.NoError(t, err)
+	require.Equal(t, "a", got)
+
+	got, err = protopatch.Get(msg, "any.@type", opt)
+	require.NoError(t, err)
+	require.Equal(t, "type.googleapis.com/protopatch.v1.TestMessage", got)
+
+	got, err = protopatch.Get(msg, "any.wellKnown.value", opt)
+	require.NoError(t, err)
+	patchtest.RequireEqual(t, structpb.NewStringValue("b"), got.(proto.Message), "got value differs")
+
+	require.False(t, protopatch.Has(&protopatchv1.TestWellKnown{}, "any.string", opt))
+}
+
+func TestWithResolver(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+	err := protopatch.Set(msg, "any.string", "b", protopatch.WithContainerTransformation(patchanypb.AnyContainerTransformer(patchanypb.WithResolver(&protoregistry.Types{}))))
+	require.Equal(t, protopatch.NewErrInPath("any", patchanypb.ErrUnknownType{URL: "type.googleapis.com/protopatch.v1.TestMessage"}), err)
+	patchtest.RequireEqual(t, &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}, msg, "message modified by failed operation")
+}
+
+func TestAnyContainerTransformInverse(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a"}}})}
+	opt := protopatch.WithContainerTransformation(patchanypb.AnyContainerTransformer())
+
+	inverse, err := protopatch.ApplyWithInverse(msg, protopatch.Patch{{Op: protopatch.OpInsert, Path: "any.list.string.0", Value: "b"}}, opt)
+	require.NoError(t, err)
+	require.Equal(t, protopatch.Patch{{Op: protopatch.OpClear, Path: "any.list.string.0"}}, inverse) // list item is removed instead of restoring the whole google.protobuf.Any message
+	require.NoError(t, protopatch.Apply(msg, inverse, opt))
+	patchtest.RequireEqual(t, &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a"}}})}, msg, "message after inverse differs")
+}
+
+func TestAnyContainerTransformMutable(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "a"}})}
+	opt := protopatch.WithContainerTransformation(patchanypb.AnyContainerTransformer())
+
+	c, err := protopatch.Access(protopatch.MessageContainer(msg), "any", opt)
+	require.NoError(t, err)
+	_, err = c.Mutable("message")
+	require.Equal(t, protopatch.ErrMutationOfReadOnlyValue, err)
+	url, err := c.Mutable(patchanypb.TypeKey)
+	require.NoError(t, err)
+	require.Equal(t, "type.googleapis.com/protopatch.v1.TestMessage", url)
+
+	c, err = protopatch.Access(protopatch.MessageContainer(msg), "any.message", opt)
+	require.NoError(t, err)
+	_, err = c.Mutable("string")
+	require.Equal(t, protopatch.ErrMutationOfReadOnlyValue, err)
+	_, err = c.Mutable("unknown")
+	require.Equal(t, protopatch.ErrNotFound{Kind: "field", Value: "unknown"}, err)
+}
+
+func TestAnyContainerTransformDynamic(t *testing.T) {
+	t.Parallel()
+
+	md := (&protopatchv1.TestWellKnown{}).ProtoReflect().Descriptor()
+	msg := dynamicpb.NewMessage(md)
+	packed := msg.Mutable(md.Fields().ByName("any")).Message()
+	packed.Set(packed.Descriptor().Fields().ByNumber(1), protoreflect.ValueOfString("type.googleapis.com/protopatch.v1.TestMessage"))
+	value, err := proto.Marshal(&protopatchv1.TestMessage{String_: "a"})
+	require.NoError(t, err)
+	packed.Set(packed.Descriptor().Fields().ByNumber(2), protoreflect.ValueOfBytes(value))
+
+	require.NoError(t, protopatch.Set(msg, "any.string", "b", protopatch.WithContainerTransformation(patchanypb.AnyContainerTransformer())))
+
+	b, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &protopatchv1.TestWellKnown{}
+	require.NoError(t, proto.Unmarshal(b, got))
+	patchtest.RequireEqual(t, &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "b"})}, got, "patched message differs")
+}
+
+func TestAnyContainerTransformerReplacedValue(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "a"})}
+	opt := protopatch.WithContainerTransformation(patchanypb.AnyContainerTransformer())
+
+	require.NoError(t, protopatch.Set(msg, "any.int32", int32(1), opt))
+	msg.Any.Value = mustPack(t, &protopatchv1.TestMessage{String_: "b"}).Value // replaced value must not be shadowed by the previously unpacked message
+	require.NoError(t, protopatch.Set(msg, "any.int64", int64(2), opt))
+	patchtest.RequireEqual(t, &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "b", Int64: 2})}, msg, "patched message differs")
+
+	err := protopatch.Apply(msg, protopatch.Patch{{Op: protopatch.OpSet, Path: "any.int32", Value: int32(3)}, {Op: protopatch.OpSet, Path: "any.int32", Value: "x"}}, opt)
+	require.Error(t, err)
+	require.NoError(t, protopatch.Set(msg, "any.int64", int64(4), opt))
+	patchtest.RequireEqual(t, &protopatchv1.TestWellKnown{Any: mustPack(t, &protopatchv1.TestMessage{String_: "b", Int32: 3, Int64: 4})}, msg, "patched message differs")
+}
@@ -0,0 +1,109 @@
+// Package patchanypb allows patch operations to descend into messages packed within google.protobuf.Any messages.
+package patchanypb
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type Option interface {
+	configure(*setup)
+}
+
+type optionFunc func(*setup)
+
+func (fn optionFunc) configure(s *setup) { fn(s) }
+
+// Resolver resolves message types by their type URLs. It is implemented by protoregistry.Types.
+type Resolver interface {
+	FindMessageByURL(url string) (protoreflect.MessageType, error)
+}
+
+// WithResolver returns option that makes unpacking and switching of packed message types use the given resolver instead of protoregistry.GlobalTypes.
+func WithResolver(resolver Resolver) Option {
+	return optionFunc(func(s *setup) {
+		s.resolver = resolver
+	})
+}
+
+type setup struct {
+	resolver Resolver
+	cache    *unpackCache
+}
+
+func newSetup(opts ...Option) *setup {
+	s := &setup{resolver: protoregistry.GlobalTypes, cache: &unpackCache{}}
+	for _, o := range opts {
+		o.configure(s)
+	}
+	return s
+}
+
+// unpackCacheSize is the number of recently unpacked messages reused by containers created with the same setup.
+const unpackCacheSize = 4
+
+// unpackCache holds recently unpacked messages together with google.protobuf.Any messages they were unpacked from. Entries are reused only as long as google.protobuf.Any messages hold the very same type URL and encoded value the entries were created for.
+type unpackCache struct {
+	mu      sync.Mutex
+	entries [unpackCacheSize]unpackCacheEntry
+	next    int // index of the entry to be replaced next
+}
+
+type unpackCacheEntry struct {
+	any   protoreflect.Message
+	url   string
+	value []byte
+	msg   proto.Message
+}
+
+// load returns message unpacked from the given google.protobuf.Any message or nil if there is no such message.
+func (uc *unpackCache) load(a protoreflect.Message, url string, value []byte) proto.Message {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	for _, e := range uc.entries {
+		if e.any == a && e.url == url && sameBytes(e.value, value) {
+			return e.msg
+		}
+	}
+	return nil
+}
+
+// store records message unpacked from the given google.protobuf.Any message (or packed into it), replacing the previous one.
+func (uc *unpackCache) store(a protoreflect.Message, url string, value []byte, msg proto.Message) {
+	if len(value) == 0 { // empty values cannot be told apart and are cheap to unpack
+		return
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	i := uc.index(a)
+	if i < 0 {
+		i, uc.next = uc.next, (uc.next+1)%unpackCacheSize
+	}
+	uc.entries[i] = unpackCacheEntry{any: a, url: url, value: value, msg: msg}
+}
+
+// drop removes message unpacked from the given google.protobuf.Any message.
+func (uc *unpackCache) drop(a protoreflect.Message) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if i := uc.index(a); i >= 0 {
+		uc.entries[i] = unpackCacheEntry{}
+	}
+}
+
+func (uc *unpackCache) index(a protoreflect.Message) int {
+	for i, e := range uc.entries {
+		if e.any != nil && e.any == a {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameBytes reports whether both slices refer to the same non-empty memory.
+func sameBytes(a, b []byte) bool {
+	return len(a) > 0 && len(a) == len(b) && &a[0] == &b[0]
+}
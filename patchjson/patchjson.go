@@ -0,0 +1,209 @@
+// Package patchjson implements JSON Patch (RFC 6902) documents on top of protopatch operations.
+//
+// JSON Pointer paths are converted into protopatch paths and each JSON Patch operation is mapped onto a protopatch operation. As Protocol Buffer messages are not arbitrary JSON documents, protopatch semantic (described in spec.md) is applied and JSON Patch semantic differs in the following cases:
+//
+//   - Message fields always exist. Operation "add" and "replace" on a message field both set the field and "remove" resets the field to its zero value, even when the field was not populated. Unknown fields are reported as errors.
+//   - Operation "replace" on a map key that does not exist adds the key, as set operation allows setting nonexisting map keys. Operation "remove" on a nonexistent map key fails.
+//   - Operation "add" on a list index inserts the value and "add" on "-" appends it, exactly as in JSON Patch. All other operations fail for indexes out of the list bounds.
+//   - Operations "move" and "copy" follow the set operation semantic for the target path. For a list index target they replace the list item rather than insert a new one and both paths are resolved before the value is removed from its original location.
+//   - Values are converted to the types of the target fields with patchstructpb.FromValueConverter, so for example 64-bit integers may be provided as JSON strings and enums as numbers. Value null clears the target element.
+//   - Operation "test" is mapped onto protopatch test operation, so the expected value is converted to the type of the current value and value null matches only elements that are not populated.
+//   - Field names within JSON Pointers may be provided as JSON names, proto names or field numbers.
+package patchjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+var (
+	ErrInvalidPointer   = protopatch.ErrInvalidJSONPointer
+	ErrUnknownOperation = errors.New("unknown JSON patch operation")
+)
+
+// ErrMissingMember is returned when a JSON Patch operation does not contain a required member.
+type ErrMissingMember struct {
+	Member string
+}
+
+func (e ErrMissingMember) Error() string {
+	return fmt.Sprintf("missing %q member", e.Member)
+}
+
+// Operation is a single JSON Patch operation.
+type Operation struct {
+	Op    string
+	Path  string
+	From  string
+	Value *structpb.Value // nil when the member is absent; JSON null is represented by structpb.NullValue
+}
+
+// Document is a JSON Patch document.
+type Document []Operation
+
+type jsonOperation struct {
+	Op    *string         `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Parse parses the given JSON Patch document. Each operation is validated to contain all required members.
+func Parse(data []byte) (Document, error) {
+	raw := []jsonOperation(nil)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	doc := make(Document, 0, len(raw))
+	for i, r := range raw {
+		op, err := parseOperation(r)
+		if err != nil {
+			return nil, protopatch.ErrInOperation{Index: i, Op: protopatch.OpKind(op.Op), Cause: err}
+		}
+		doc = append(doc, op)
+	}
+	return doc, nil
+}
+
+func parseOperation(r jsonOperation) (Operation, error) {
+	op := Operation{}
+	if r.Op == nil {
+		return op, ErrMissingMember{Member: "op"}
+	}
+	op.Op = *r.Op
+	if r.Path == nil {
+		return op, ErrMissingMember{Member: "path"}
+	}
+	op.Path = *r.Path
+	switch op.Op {
+	case "add", "replace", "test":
+		if r.Value == nil {
+			return op, ErrMissingMember{Member: "value"}
+		}
+		op.Value = &structpb.Value{}
+		if err := protojson.Unmarshal(r.Value, op.Value); err != nil {
+			return op, err
+		}
+	case "move", "copy":
+		if r.From == nil {
+			return op, ErrMissingMember{Member: "from"}
+		}
+		op.From = *r.From
+	case "remove":
+	default:
+		return op, ErrUnknownOperation
+	}
+	return op, nil
+}
+
+// Apply parses the given JSON Patch document and applies it to the base message. See Document.Apply for details.
+func Apply(base proto.Message, data []byte, opts ...protopatch.Option) error {
+	doc, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	return doc.Apply(base, opts...)
+}
+
+// Apply applies the document to the base message. Values are converted to the target types with patchstructpb.FromValueConverter, which is used after all converters provided with the given options. Each "add" operation is resolved into insert, append or set operation right before it is applied, so it observes changes made by preceding operations of the document. The resolution is performed after all operation resolvers provided with the given options, which receive "add" operations as operations of OpAdd kind. Failures are reported as protopatch.ErrInOperation errors with the index of the failing JSON Patch operation.
+func (d Document) Apply(base proto.Message, opts ...protopatch.Option) error {
+	opts = append(opts[:len(opts):len(opts)], protopatch.WithAdditionalConversion(patchstructpb.FromValueConverter()))
+	patch := make(protopatch.Patch, 0, len(d))
+	for i, op := range d {
+		o, err := toOperation(op)
+		if err != nil {
+			return protopatch.ErrInOperation{Index: i, Op: protopatch.OpKind(op.Op), Cause: err}
+		}
+		patch = append(patch, o)
+	}
+	opts = append(opts, protopatch.WithAdditionalOperationResolution(addResolver(opts)))
+	return protopatch.Apply(base, patch, opts...)
+}
+
+// OpAdd is the kind of operations produced for JSON Patch "add" operations. They are resolved into insert, append or set operations right before they are applied (see Document.Apply).
+const OpAdd protopatch.OpKind = "add"
+
+func toOperation(op Operation) (protopatch.Operation, error) {
+	path, err := protopatch.ParseJSONPointer(op.Path)
+	if err != nil {
+		return protopatch.Operation{}, err
+	}
+	switch op.Op {
+	case "add":
+		return protopatch.Operation{Op: OpAdd, Path: string(path), Value: op.Value}, nil
+	case "remove":
+		return protopatch.Operation{Op: protopatch.OpClear, Path: string(path)}, nil
+	case "replace":
+		return protopatch.Operation{Op: protopatch.OpSet, Path: string(path), Value: op.Value}, nil
+	case "test":
+		return protopatch.Operation{Op: protopatch.OpTest, Path: string(path), Value: op.Value}, nil
+	case "move", "copy":
+		from, err := protopatch.ParseJSONPointer(op.From)
+		if err != nil {
+			return protopatch.Operation{}, err
+		}
+		kind := protopatch.OpMove
+		if op.Op == "copy" {
+			kind = protopatch.OpCopy
+		}
+		return protopatch.Operation{Op: kind, Path: string(path), From: string(from)}, nil
+	}
+	return protopatch.Operation{}, ErrUnknownOperation
+}
+
+// addResolver returns resolver turning "add" operations into insert or append operations when the parent of the target path is a list and into set operations otherwise.
+func addResolver(opts []protopatch.Option) protopatch.OperationResolver {
+	return protopatch.OperationResolverFunc(func(base proto.Message, op protopatch.Operation) (protopatch.Operation, error) {
+		if op.Op != OpAdd {
+			return op, nil
+		}
+		path := protopatch.Path(op.Path)
+		if path == "" {
+			return protopatch.Operation{Op: protopatch.OpSet, Path: op.Path, Value: op.Value}, nil
+		}
+		last := path.Last()
+		list, err := isListParent(base, last, opts)
+		if err != nil {
+			return op, err
+		}
+		switch {
+		case list && last.Value() == protopatch.PathEndOfList:
+			return protopatch.Operation{Op: protopatch.OpAppend, Path: string(last.PrecedingPath()), Value: op.Value}, nil
+		case list:
+			return protopatch.Operation{Op: protopatch.OpInsert, Path: op.Path, Value: op.Value}, nil
+		}
+		return protopatch.Operation{Op: protopatch.OpSet, Path: op.Path, Value: op.Value}, nil
+	})
+}
+
+// accessParent returns container holding the last segment of the given path.
+func accessParent(base proto.Message, last protopatch.PathSegment, opts []protopatch.Option) (protopatch.Container, error) {
+	if last.IsFirst() {
+		return protopatch.MessageContainer(base), nil
+	}
+	return protopatch.Access(protopatch.MessageContainer(base), last.PrecedingPath(), opts...)
+}
+
+func isListParent(base proto.Message, last protopatch.PathSegment, opts []protopatch.Option) (bool, error) {
+	c, err := accessParent(base, last, opts)
+	if err != nil {
+		return false, err
+	}
+	switch s := c.Self().(type) {
+	case protopatch.List:
+		return true, nil
+	case *structpb.ListValue:
+		return true, nil
+	case *structpb.Value:
+		return s.GetListValue() != nil, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,220 @@
+package patchjson_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchjson"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		base    proto.Message
+		patch   string
+		opts    []protopatch.Option
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name:  "empty",
+			base:  &protopatchv1.TestMessage{String_: "aaa"},
+			patch: `[]`,
+			want:  &protopatchv1.TestMessage{String_: "aaa"},
+		},
+		{
+			name: "add-replace-remove",
+			base: &protopatchv1.TestMessage{String_: "aaa", Int32: 1},
+			patch: `[
+				{"op": "add", "path": "/string", "value": "bbb"},
+				{"op": "replace", "path": "/int64", "value": "5"},
+				{"op": "remove", "path": "/int32"},
+				{"op": "add", "path": "/message", "value": {"string": "ccc"}}
+			]`,
+			want: &protopatchv1.TestMessage{String_: "bbb", Int64: 5, Message: &protopatchv1.TestMessage{String_: "ccc"}},
+		},
+		{
+			name: "list",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b"}}},
+			patch: `[
+				{"op": "add", "path": "/list/string/-", "value": "c"},
+				{"op": "add", "path": "/list/string/0", "value": "d"},
+				{"op": "replace", "path": "/list/string/1", "value": "e"},
+				{"op": "remove", "path": "/list/string/3"}
+			]`,
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"d", "e", "b"}}},
+		},
+		{
+			name: "map",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a": "a", "b": "b"}}},
+			patch: `[
+				{"op": "add", "path": "/map/stringToString/c", "value": "c"},
+				{"op": "add", "path": "/map/stringToString/-", "value": "d"},
+				{"op": "replace", "path": "/map/string_to_string/a~1b", "value": "e"},
+				{"op": "remove", "path": "/map/stringToString/b"}
+			]`,
+			want: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a": "a", "c": "c", "-": "d", "a/b": "e"}}},
+		},
+		{
+			name: "move-copy",
+			base: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{}, Map: &protopatchv1.TestMap{StringToString: map[string]string{"a": "a"}}},
+			patch: `[
+				{"op": "copy", "path": "/message/string", "from": "/string"},
+				{"op": "move", "path": "/map/stringToString/b", "from": "/map/stringToString/a"}
+			]`,
+			want: &protopatchv1.TestMessage{
+				String_: "aaa",
+				Message: &protopatchv1.TestMessage{String_: "aaa"},
+				Map:     &protopatchv1.TestMap{StringToString: map[string]string{"b": "a"}},
+			},
+		},
+		{
+			name: "test",
+			base: &protopatchv1.TestMessage{String_: "aaa", Int64: 5, List: &protopatchv1.TestList{String_: []string{"a"}}},
+			patch: `[
+				{"op": "test", "path": "/string", "value": "aaa"},
+				{"op": "test", "path": "/int64", "value": 5},
+				{"op": "test", "path": "/list/string", "value": ["a"]},
+				{"op": "replace", "path": "/string", "value": "bbb"},
+				{"op": "test", "path": "/string", "value": "bbb"}
+			]`,
+			want: &protopatchv1.TestMessage{String_: "bbb", Int64: 5, List: &protopatchv1.TestList{String_: []string{"a"}}},
+		},
+		{
+			name: "structpb",
+			base: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("a")}}),
+			}},
+			patch: `[
+				{"op": "add", "path": "/wellKnown/value/b", "value": {"key": 1}},
+				{"op": "test", "path": "/wellKnown/value/a", "value": "a"},
+				{"op": "remove", "path": "/wellKnown/value/a"}
+			]`,
+			opts: []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())},
+			want: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"b": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewNumberValue(1)}}),
+				}}),
+			}},
+		},
+		{
+			name: "add-into-container-created-by-preceding-operation",
+			base: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{Struct: &structpb.Struct{}}},
+			patch: `[
+				{"op": "add", "path": "/wellKnown/struct/a", "value": []},
+				{"op": "add", "path": "/wellKnown/struct/a/0", "value": 1},
+				{"op": "add", "path": "/wellKnown/struct/a/-", "value": 3},
+				{"op": "add", "path": "/wellKnown/struct/a/1", "value": 2}
+			]`,
+			opts: []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())},
+			want: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{Struct: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"a": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewNumberValue(2), structpb.NewNumberValue(3)}}),
+			}}}},
+		},
+		{
+			name: "custom-operation-resolver",
+			base: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{}, List: &protopatchv1.TestList{String_: []string{"a"}}},
+			patch: `[
+				{"op": "add", "path": "/string", "value": "bbb"},
+				{"op": "add", "path": "/list/string/0", "value": "x"}
+			]`,
+			opts: []protopatch.Option{protopatch.WithOperationResolution(protopatch.OperationResolverFunc(func(_ proto.Message, op protopatch.Operation) (protopatch.Operation, error) {
+				if op.Op == patchjson.OpAdd && op.Path == "string" {
+					op.Path = "message.string"
+				}
+				return op, nil
+			}))},
+			want: &protopatchv1.TestMessage{
+				Message: &protopatchv1.TestMessage{String_: "bbb"},
+				List:    &protopatchv1.TestList{String_: []string{"x", "a"}},
+			},
+		},
+		{
+			name: "add-with-missing-parent",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{}},
+			patch: `[
+				{"op": "add", "path": "/map/stringToMessage/a/string", "value": "a"}
+			]`,
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: "add", Cause: protopatch.NewErrInPath("map.stringToMessage", protopatch.ErrNotFound{Kind: "key", Value: "a"})},
+		},
+		{
+			name: "test-failure",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: `[
+				{"op": "replace", "path": "/int32", "value": 1},
+				{"op": "test", "path": "/message/string", "value": "bbb"}
+			]`,
+			wantErr: protopatch.ErrInOperation{Index: 1, Op: "test", Cause: protopatch.NewErrInPath("message.string", protopatch.ErrTestFailed{Expected: "bbb", Actual: ""})},
+		},
+		{
+			name: "failure-index",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: `[
+				{"op": "test", "path": "/string", "value": "aaa"},
+				{"op": "replace", "path": "/int32", "value": 1},
+				{"op": "remove", "path": "/unknown"}
+			]`,
+			wantErr: protopatch.ErrInOperation{Index: 2, Op: protopatch.OpClear, Cause: protopatch.NewErrInPath("unknown", protopatch.ErrNotFound{Kind: "field", Value: "unknown"})},
+		},
+		{
+			name:    "missing-value",
+			base:    &protopatchv1.TestMessage{},
+			patch:   `[{"op": "add", "path": "/string"}]`,
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: "add", Cause: patchjson.ErrMissingMember{Member: "value"}},
+		},
+		{
+			name:    "missing-from",
+			base:    &protopatchv1.TestMessage{},
+			patch:   `[{"op": "move", "path": "/string"}]`,
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: "move", Cause: patchjson.ErrMissingMember{Member: "from"}},
+		},
+		{
+			name:    "unknown-operation",
+			base:    &protopatchv1.TestMessage{},
+			patch:   `[{"op": "unknown", "path": "/string"}]`,
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: "unknown", Cause: patchjson.ErrUnknownOperation},
+		},
+		{
+			name:    "invalid-pointer",
+			base:    &protopatchv1.TestMessage{},
+			patch:   `[{"op": "remove", "path": "string"}]`,
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: "remove", Cause: patchjson.ErrInvalidPointer},
+		},
+		{
+			name:    "invalid-escape",
+			base:    &protopatchv1.TestMessage{},
+			patch:   `[{"op": "remove", "path": "/map/stringToString/a~2"}]`,
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: "remove", Cause: patchjson.ErrInvalidPointer},
+		},
+		{
+			name:  "special-characters-in-keys",
+			base:  &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a.b": "a", "c/d~": "c", "*": "e"}}},
+			patch: `[{"op": "remove", "path": "/map/stringToString/a.b"}, {"op": "replace", "path": "/map/stringToString/c~1d~0", "value": "x"}, {"op": "remove", "path": "/map/stringToString/*"}]`,
+			want:  &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"c/d~": "x"}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			base := proto.Clone(test.base)
+			err := patchjson.Apply(base, []byte(test.patch), test.opts...)
+
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, base, "message after apply mismatch")
+		})
+	}
+}
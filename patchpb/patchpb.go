@@ -0,0 +1,121 @@
+// Package patchpb allows to exchange patches as protopatch.v1.Patch messages.
+package patchpb
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/patchstructpb"
+	protopatchv1 "github.com/daishe/protopatch/types/protopatch/v1"
+)
+
+var ErrMissingValue = errors.New("missing value of the operation")
+
+var (
+	toOpKind = map[protopatchv1.Operation_Op]protopatch.OpKind{
+		protopatchv1.Operation_OP_SET:    protopatch.OpSet,
+		protopatchv1.Operation_OP_APPEND: protopatch.OpAppend,
+		protopatchv1.Operation_OP_INSERT: protopatch.OpInsert,
+		protopatchv1.Operation_OP_CLEAR:  protopatch.OpClear,
+		protopatchv1.Operation_OP_COPY:   protopatch.OpCopy,
+		protopatchv1.Operation_OP_MOVE:   protopatch.OpMove,
+		protopatchv1.Operation_OP_SWAP:   protopatch.OpSwap,
+		protopatchv1.Operation_OP_TEST:   protopatch.OpTest,
+	}
+	fromOpKind = func() map[protopatch.OpKind]protopatchv1.Operation_Op {
+		m := make(map[protopatch.OpKind]protopatchv1.Operation_Op, len(toOpKind))
+		for k, v := range toOpKind {
+			m[v] = k
+		}
+		return m
+	}()
+)
+
+// Apply decodes the given patch message and applies it to the base message. Payloads of google.protobuf.Value kind are converted to the target types with patchstructpb.FromValueConverter, which is used after all converters provided with the given options.
+func Apply(base proto.Message, patch *protopatchv1.Patch, opts ...protopatch.Option) error {
+	p, err := FromProto(patch)
+	if err != nil {
+		return err
+	}
+	opts = append(opts[:len(opts):len(opts)], protopatch.WithAdditionalConversion(patchstructpb.FromValueConverter()))
+	return protopatch.Apply(base, p, opts...)
+}
+
+// FromProto decodes the given patch message. Payloads of google.protobuf.Value kind are stored as *structpb.Value values and require conversion when the patch is applied (see patchstructpb.FromValueConverter). Payloads of google.protobuf.Any kind are unpacked using the global types registry.
+func FromProto(patch *protopatchv1.Patch) (protopatch.Patch, error) {
+	ops := patch.GetOperations()
+	p := make(protopatch.Patch, 0, len(ops))
+	for i, o := range ops {
+		op, err := operationFromProto(o)
+		if err != nil {
+			return nil, protopatch.ErrInOperation{Index: i, Op: op.Op, Cause: err}
+		}
+		p = append(p, op)
+	}
+	return p, nil
+}
+
+func operationFromProto(o *protopatchv1.Operation) (protopatch.Operation, error) {
+	kind, ok := toOpKind[o.GetOp()]
+	if !ok {
+		return protopatch.Operation{Op: protopatch.OpKind(o.GetOp().String())}, protopatch.ErrUnknownOperation
+	}
+	op := protopatch.Operation{Op: kind, Path: o.GetPath(), From: o.GetFrom()}
+	switch p := o.GetPayload().(type) {
+	case *protopatchv1.Operation_Value:
+		op.Value = p.Value
+	case *protopatchv1.Operation_Any:
+		m, err := anypb.UnmarshalNew(p.Any, proto.UnmarshalOptions{})
+		if err != nil {
+			return op, err
+		}
+		op.Value = m
+	}
+	if op.Value == nil && (kind == protopatch.OpAppend || kind == protopatch.OpInsert) {
+		return op, ErrMissingValue
+	}
+	return op, nil
+}
+
+// ToProto encodes the given patch as a patch message. Message values are packed into google.protobuf.Any payloads and all other values are converted to google.protobuf.Value payloads with patchstructpb.ConvertToValue.
+func ToProto(patch protopatch.Patch) (*protopatchv1.Patch, error) {
+	p := &protopatchv1.Patch{Operations: make([]*protopatchv1.Operation, 0, len(patch))}
+	for i, op := range patch {
+		o, err := operationToProto(op)
+		if err != nil {
+			return nil, protopatch.ErrInOperation{Index: i, Op: op.Op, Cause: err}
+		}
+		p.Operations = append(p.Operations, o)
+	}
+	return p, nil
+}
+
+func operationToProto(op protopatch.Operation) (*protopatchv1.Operation, error) {
+	kind, ok := fromOpKind[op.Op]
+	if !ok {
+		return nil, protopatch.ErrUnknownOperation
+	}
+	o := &protopatchv1.Operation{Op: kind, Path: op.Path, From: op.From}
+	switch v := op.Value.(type) {
+	case nil:
+	case *structpb.Value:
+		o.Payload = &protopatchv1.Operation_Value{Value: v}
+	case proto.Message:
+		a, err := anypb.New(v)
+		if err != nil {
+			return nil, err
+		}
+		o.Payload = &protopatchv1.Operation_Any{Any: a}
+	default:
+		conv, err := patchstructpb.ConvertToValue(&structpb.Value{}, v)
+		if err != nil {
+			return nil, err
+		}
+		o.Payload = &protopatchv1.Operation_Value{Value: conv.(*structpb.Value)}
+	}
+	return o, nil
+}
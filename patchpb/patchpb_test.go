@@ -0,0 +1,174 @@
+package patchpb_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchpb"
+	"github.com/daishe/protopatch/patchstructpb"
+	patchpbv1 "github.com/daishe/protopatch/types/protopatch/v1"
+)
+
+func mustAny(t *testing.T, m proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(m)
+	require.NoError(t, err)
+	return a
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		base    proto.Message
+		patch   *patchpbv1.Patch
+		want    proto.Message
+		opts    []protopatch.Option
+		wantErr error
+	}{
+		{
+			name: "value-payloads",
+			base: &protopatchv1.TestMessage{String_: "aaa", List: &protopatchv1.TestList{Int32: []int32{1}}},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_SET, Path: "string", Payload: &patchpbv1.Operation_Value{Value: structpb.NewStringValue("bbb")}},
+				{Op: patchpbv1.Operation_OP_SET, Path: "int64", Payload: &patchpbv1.Operation_Value{Value: structpb.NewNumberValue(5)}},
+				{Op: patchpbv1.Operation_OP_APPEND, Path: "list.int32", Payload: &patchpbv1.Operation_Value{Value: structpb.NewNumberValue(2)}},
+				{Op: patchpbv1.Operation_OP_INSERT, Path: "list.int32.0", Payload: &patchpbv1.Operation_Value{Value: structpb.NewStringValue("3")}},
+				{Op: patchpbv1.Operation_OP_SET, Path: "message", Payload: &patchpbv1.Operation_Value{Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"string": structpb.NewStringValue("ccc"),
+				}})}},
+			}},
+			want: &protopatchv1.TestMessage{
+				String_: "bbb",
+				Int64:   5,
+				Message: &protopatchv1.TestMessage{String_: "ccc"},
+				List:    &protopatchv1.TestList{Int32: []int32{3, 1, 2}},
+			},
+		},
+		{
+			name: "any-payload",
+			base: &protopatchv1.TestMessage{},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_SET, Path: "message", Payload: &patchpbv1.Operation_Any{Any: mustAny(t, &protopatchv1.TestMessage{String_: "aaa"})}},
+			}},
+			want: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "aaa"}},
+		},
+		{
+			name: "clear-and-null",
+			base: &protopatchv1.TestMessage{String_: "aaa", Int32: 1, Bool: true},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_CLEAR, Path: "string"},
+				{Op: patchpbv1.Operation_OP_SET, Path: "int32", Payload: &patchpbv1.Operation_Value{Value: structpb.NewNullValue()}},
+				{Op: patchpbv1.Operation_OP_SET, Path: "bool"},
+			}},
+			want: &protopatchv1.TestMessage{},
+		},
+		{
+			name: "two-paths",
+			base: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "bbb"}},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_SWAP, Path: "string", From: "message.string"},
+				{Op: patchpbv1.Operation_OP_COPY, Path: "message.message", From: "message"},
+				{Op: patchpbv1.Operation_OP_MOVE, Path: "message.message.int32", From: "int32"},
+			}},
+			want: &protopatchv1.TestMessage{String_: "bbb", Message: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "aaa"}}},
+		},
+		{
+			name: "caller-converters",
+			base: &protopatchv1.TestMessage{},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_SET, Path: "string", Payload: &patchpbv1.Operation_Value{Value: structpb.NewStringValue("aaa")}},
+				{Op: patchpbv1.Operation_OP_SET, Path: "int32", Payload: &patchpbv1.Operation_Value{Value: structpb.NewNumberValue(5)}},
+			}},
+			opts: []protopatch.Option{protopatch.WithConversion(protopatch.ConverterFunc(func(to, from any) (any, error) {
+				if v, ok := from.(*structpb.Value); ok && to == "" {
+					return strings.ToUpper(v.GetStringValue()), nil
+				}
+				return nil, protopatch.ErrNoConversionDefined
+			}))},
+			want: &protopatchv1.TestMessage{String_: "AAA", Int32: 5},
+		},
+		{
+			name: "unspecified-operation",
+			base: &protopatchv1.TestMessage{},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_CLEAR, Path: "string"},
+				{Path: "string"},
+			}},
+			wantErr: protopatch.ErrInOperation{Index: 1, Op: "OP_UNSPECIFIED", Cause: protopatch.ErrUnknownOperation},
+		},
+		{
+			name: "missing-value",
+			base: &protopatchv1.TestMessage{},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_APPEND, Path: "list.string"},
+			}},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpAppend, Cause: patchpb.ErrMissingValue},
+		},
+		{
+			name: "apply-failure",
+			base: &protopatchv1.TestMessage{},
+			patch: &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+				{Op: patchpbv1.Operation_OP_SET, Path: "int32", Payload: &patchpbv1.Operation_Value{Value: structpb.NewStringValue("x")}},
+			}},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpSet, Cause: protopatch.NewErrInPath("int32", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType})},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			base := proto.Clone(test.base)
+			err := patchpb.Apply(base, test.patch, test.opts...)
+
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, base, "message after apply mismatch")
+		})
+	}
+}
+
+func TestToProto(t *testing.T) {
+	t.Parallel()
+
+	patch := protopatch.Patch{
+		{Op: protopatch.OpSet, Path: "string", Value: "aaa"},
+		{Op: protopatch.OpSet, Path: "int32", Value: int32(5)},
+		{Op: protopatch.OpSet, Path: "message", Value: &protopatchv1.TestMessage{String_: "bbb"}},
+		{Op: protopatch.OpAppend, Path: "list.string", Value: structpb.NewStringValue("ccc")},
+		{Op: protopatch.OpClear, Path: "bool"},
+		{Op: protopatch.OpMove, Path: "message.string", From: "string"},
+	}
+	want := &patchpbv1.Patch{Operations: []*patchpbv1.Operation{
+		{Op: patchpbv1.Operation_OP_SET, Path: "string", Payload: &patchpbv1.Operation_Value{Value: structpb.NewStringValue("aaa")}},
+		{Op: patchpbv1.Operation_OP_SET, Path: "int32", Payload: &patchpbv1.Operation_Value{Value: structpb.NewNumberValue(5)}},
+		{Op: patchpbv1.Operation_OP_SET, Path: "message", Payload: &patchpbv1.Operation_Any{Any: mustAny(t, &protopatchv1.TestMessage{String_: "bbb"})}},
+		{Op: patchpbv1.Operation_OP_APPEND, Path: "list.string", Payload: &patchpbv1.Operation_Value{Value: structpb.NewStringValue("ccc")}},
+		{Op: patchpbv1.Operation_OP_CLEAR, Path: "bool"},
+		{Op: patchpbv1.Operation_OP_MOVE, Path: "message.string", From: "string"},
+	}}
+
+	got, err := patchpb.ToProto(patch)
+	require.NoError(t, err)
+	patchtest.RequireEqual(t, want, got, "patch message mismatch")
+
+	base, viaProto := &protopatchv1.TestMessage{List: &protopatchv1.TestList{}}, &protopatchv1.TestMessage{List: &protopatchv1.TestList{}}
+	require.NoError(t, protopatch.Apply(base, patch[:len(patch)-2], protopatch.WithConversion(patchstructpb.FromValueConverter())))
+	require.NoError(t, patchpb.Apply(viaProto, &patchpbv1.Patch{Operations: got.Operations[:len(got.Operations)-2]}))
+	patchtest.RequireEqual(t, base, viaProto, "message after apply mismatch")
+
+	_, err = patchpb.ToProto(protopatch.Patch{{Op: "unknown"}})
+	require.Equal(t, protopatch.ErrInOperation{Index: 0, Op: "unknown", Cause: protopatch.ErrUnknownOperation}, err)
+}
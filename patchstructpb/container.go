@@ -1,6 +1,9 @@
 package patchstructpb
 
 import (
+	"slices"
+	"strconv"
+
 	"github.com/daishe/protopatch"
 	"github.com/daishe/protopatch/internal/protoops"
 	"google.golang.org/protobuf/proto"
@@ -140,6 +143,15 @@ func (c *structValueContainer) Insert(_ string, _ any) error {
 	return protopatch.ErrAppendToNonList
 }
 
+func (c *structValueContainer) Keys() []string {
+	keys := make([]string, 0, len(c.st.GetFields()))
+	for k := range c.st.GetFields() {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
 type listValueContainer struct {
 	li *structpb.ListValue
 }
@@ -171,7 +183,7 @@ func (c *listValueContainer) GetCopy(index string) (any, error) {
 
 func (c *listValueContainer) GetNew(index string) (any, error) {
 	_, ok := protoops.ParseListIndex(index)
-	if !ok {
+	if !ok && index != protopatch.PathEndOfList {
 		return nil, protopatch.ErrNotFound{Kind: "index", Value: index}
 	}
 	return &structpb.Value{}, nil
@@ -246,6 +258,9 @@ func (c *listValueContainer) Insert(index string, new any) error {
 		return protopatch.ErrMutationOfReadOnlyValue
 	}
 	idx := protoops.ParsedIndexInList(len(c.li.Values)+1, index)
+	if index == protopatch.PathEndOfList {
+		idx = len(c.li.Values)
+	}
 	if idx < 0 {
 		return protopatch.ErrNotFound{Kind: "index", Value: index}
 	}
@@ -260,6 +275,14 @@ func (c *listValueContainer) Insert(index string, new any) error {
 	return nil
 }
 
+func (c *listValueContainer) Keys() []string {
+	keys := make([]string, len(c.li.GetValues()))
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	return keys
+}
+
 type valueContainer struct {
 	v *structpb.Value
 }
@@ -399,3 +422,16 @@ func (c *valueContainer) Insert(key string, new any) error {
 		return protopatch.ErrAccessToNonContainer
 	}
 }
+
+func (c *valueContainer) Keys() []string {
+	switch k := c.v.GetKind().(type) {
+	case *structpb.Value_StructValue:
+		c := structValueContainer{st: k.StructValue}
+		return c.Keys()
+	case *structpb.Value_ListValue:
+		c := listValueContainer{li: k.ListValue}
+		return c.Keys()
+	default:
+		return nil
+	}
+}
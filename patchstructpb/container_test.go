@@ -99,3 +99,62 @@ func TestValueContainerTransform(t *testing.T) {
 		})
 	}
 }
+
+func TestValueContainerTransformTopLevelKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		given *structpb.Struct
+		apply func(proto.Message, ...protopatch.Option) error
+		want  *structpb.Struct
+	}{
+		{
+			name:  "set/escaped-key",
+			given: &structpb.Struct{},
+			apply: func(base proto.Message, opts ...protopatch.Option) error {
+				return protopatch.Set(base, `example\.com`, structpb.NewStringValue("aaa"), opts...)
+			},
+			want: &structpb.Struct{Fields: map[string]*structpb.Value{"example.com": structpb.NewStringValue("aaa")}},
+		},
+		{
+			name:  "set/quoted-key",
+			given: &structpb.Struct{},
+			apply: func(base proto.Message, opts ...protopatch.Option) error {
+				return protopatch.Set(base, `["example.com"]`, structpb.NewStringValue("aaa"), opts...)
+			},
+			want: &structpb.Struct{Fields: map[string]*structpb.Value{"example.com": structpb.NewStringValue("aaa")}},
+		},
+		{
+			name:  "clear/escaped-key",
+			given: &structpb.Struct{Fields: map[string]*structpb.Value{"example.com": structpb.NewStringValue("aaa"), "other": structpb.NewStringValue("bbb")}},
+			apply: func(base proto.Message, opts ...protopatch.Option) error {
+				return protopatch.Clear(base, `example\.com`, opts...)
+			},
+			want: &structpb.Struct{Fields: map[string]*structpb.Value{"other": structpb.NewStringValue("bbb")}},
+		},
+		{
+			name:  "clear/quoted-key",
+			given: &structpb.Struct{Fields: map[string]*structpb.Value{"example.com": structpb.NewStringValue("aaa"), "other": structpb.NewStringValue("bbb")}},
+			apply: func(base proto.Message, opts ...protopatch.Option) error {
+				return protopatch.Clear(base, `["example.com"]`, opts...)
+			},
+			want: &structpb.Struct{Fields: map[string]*structpb.Value{"other": structpb.NewStringValue("bbb")}},
+		},
+		{
+			name:  "move/quoted-keys",
+			given: &structpb.Struct{Fields: map[string]*structpb.Value{"example.com": structpb.NewStringValue("aaa")}},
+			apply: func(base proto.Message, opts ...protopatch.Option) error {
+				return protopatch.Move(base, `["example.org"]`, `["example.com"]`, opts...)
+			},
+			want: &structpb.Struct{Fields: map[string]*structpb.Value{"example.org": structpb.NewStringValue("aaa")}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			base := proto.Clone(test.given)
+			err := test.apply(base, protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer()))
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, base, "wrong value after operation")
+		})
+	}
+}
@@ -1,21 +1,33 @@
 package patchstructpb
 
 import (
+	"encoding/base64"
 	"strconv"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/daishe/protopatch"
 	"github.com/daishe/protopatch/internal/protoops"
 )
 
-// FromValueConverter returns a converter that attempts to convert structpb.Value (or a value that is part of structpb.Value, like structpb.NullValue, structpb.Struct, structpb.ListValue, float64, string or bool) to appropriate proto type.
+// FromValueConverter returns a converter that attempts to convert structpb.Value (or a value that is part of structpb.Value, like structpb.NullValue, structpb.Struct, structpb.ListValue, float64, string or bool) to appropriate proto type. Returned converter implements protopatch.EnumConverter, so that enum values can be resolved by their names. Enum numbers and enum values are converted to values of enums as well, so that options applied to numbers (see RejectUnknownEnumNumbers) apply to them too.
 func FromValueConverter(opts ...Option) protopatch.Converter {
-	setup := newSetup(opts...)
-	return protopatch.ConverterFunc(func(to, from any) (any, error) {
-		return fromAny(to, from, setup)
-	})
+	return fromValueConverter{setup: newSetup(opts...)}
+}
+
+type fromValueConverter struct {
+	setup *setup
+}
+
+func (c fromValueConverter) Convert(to, from any) (any, error) {
+	return fromAny(to, from, c.setup)
+}
+
+func (c fromValueConverter) ConvertEnum(enum protoreflect.EnumDescriptor, from any) (any, error) {
+	return fromAny(protoops.NewEnum(enum, 0), from, c.setup)
 }
 
 // ConvertFromValue attempts to convert structpb.Value (or a value that is part of structpb.Value, like structpb.NullValue, structpb.Struct, structpb.ListValue, float64, string or bool) to appropriate proto type.
@@ -39,6 +51,12 @@ func fromAny(to, from any, setup *setup) (any, error) {
 		return fromListValue(to, v, setup)
 	case *structpb.Value:
 		return fromValue(to, v, setup)
+	case protoreflect.EnumNumber:
+		return fromEnumNumber(to, v, setup)
+	case protoreflect.Enum:
+		if t, ok := to.(protoreflect.Enum); ok && t.Descriptor().FullName() == v.Descriptor().FullName() {
+			return fromEnumNumber(to, v.Number(), setup)
+		}
 	}
 	// if setup.convertFromInterface {
 	// 	switch v := from.(type) {
@@ -72,8 +90,12 @@ func fromValue(to any, from *structpb.Value, setup *setup) (conv any, err error)
 	}
 }
 
-func fromNumberValue(to any, from float64, _ *setup) (any, error) {
-	switch to.(type) {
+func fromNumberValue(to any, from float64, setup *setup) (any, error) {
+	switch t := to.(type) {
+	case protoreflect.Enum:
+		if v := protoreflect.EnumNumber(from); from == float64(v) {
+			return enumFromNumber(t.Descriptor(), v, setup)
+		}
 	case int32:
 		if v := int32(from); from == float64(v) {
 			return v, nil
@@ -98,12 +120,24 @@ func fromNumberValue(to any, from float64, _ *setup) (any, error) {
 	return nil, protopatch.ErrNoConversionDefined
 }
 
-func fromStringValue(to any, from string, _ *setup) (any, error) {
-	switch to.(type) {
+func fromStringValue(to any, from string, setup *setup) (any, error) {
+	switch t := to.(type) {
+	case protoreflect.Enum:
+		if ev := t.Descriptor().Values().ByName(protoreflect.Name(from)); ev != nil {
+			return ev.Number(), nil
+		}
+		if v, err := strconv.ParseInt(from, 0, 32); err == nil {
+			return enumFromNumber(t.Descriptor(), protoreflect.EnumNumber(v), setup)
+		}
 	case string:
 		return from, nil
 	case []byte:
-		return []byte(from), nil
+		if !setup.base64Bytes {
+			return []byte(from), nil
+		}
+		if v, err := decodeBase64(from); err == nil {
+			return v, nil
+		}
 	case int32:
 		if v, err := strconv.ParseInt(from, 0, 32); err == nil {
 			return int32(v), nil
@@ -132,6 +166,38 @@ func fromStringValue(to any, from string, _ *setup) (any, error) {
 	return nil, protopatch.ErrNoConversionDefined
 }
 
+// decodeBase64 decodes the given string using standard or URL-safe base64 encoding, with or without padding, the same way as protojson does.
+func decodeBase64(s string) ([]byte, error) {
+	enc := base64.StdEncoding
+	if strings.ContainsAny(s, "-_") {
+		enc = base64.URLEncoding
+	}
+	if len(s)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	return enc.DecodeString(s)
+}
+
+// enumFromNumber returns the given enum number, if it is a valid value of the given enum.
+func enumFromNumber(enum protoreflect.EnumDescriptor, num protoreflect.EnumNumber, setup *setup) (any, error) {
+	if enum.Values().ByNumber(num) == nil && (enum.IsClosed() || setup.rejectUnknownEnumNumbers) {
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	return num, nil
+}
+
+// fromEnumNumber converts enum number (provided directly instead of JSON value) to value of the enum, applying the same rules as for JSON numbers.
+func fromEnumNumber(to any, from protoreflect.EnumNumber, setup *setup) (any, error) {
+	t, ok := to.(protoreflect.Enum)
+	if !ok {
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	if _, err := enumFromNumber(t.Descriptor(), from, setup); err != nil {
+		return nil, protopatch.ErrUnknownEnumNumber{Enum: string(t.Descriptor().FullName()), Number: from}
+	}
+	return from, nil
+}
+
 func fromBoolValue(to any, from bool, _ *setup) (any, error) {
 	if _, ok := to.(bool); ok {
 		return from, nil
@@ -180,7 +246,7 @@ func messageFromStructValue(to proto.Message, from *structpb.Struct, setup *setu
 			}
 			return nil, protopatch.ErrNoConversionDefined
 		}
-		conv, err := fromValue(protoops.InterfaceOfMessageField(f, pr.NewField(f)), v, setup)
+		conv, err := fromValue(protoops.EnumRef(f.Enum(), protoops.InterfaceOfMessageField(f, pr.NewField(f))), v, setup)
 		if err != nil {
 			if setup.clearInvalidSourceValues {
 				delete(from.GetFields(), k)
@@ -223,7 +289,7 @@ func mapFromStructValue(to protopatch.Map, from *structpb.Struct, setup *setup)
 			}
 			return nil, protopatch.ErrNoConversionDefined
 		}
-		conv, err := fromValue(protoops.InterfaceOfMapItem(desc, to.NewValue()), v, setup)
+		conv, err := fromValue(protoops.EnumRef(desc.MapValue().Enum(), protoops.InterfaceOfMapItem(desc, to.NewValue())), v, setup)
 		if err != nil {
 			if setup.clearInvalidSourceValues {
 				delete(from.GetFields(), k)
@@ -259,7 +325,7 @@ func listValueToList(to protopatch.List, from *structpb.ListValue, setup *setup)
 	}
 	for i := 0; i < len(from.GetValues()); i++ {
 		v := from.GetValues()[i]
-		conv, err := fromValue(protoops.InterfaceOfListItem(desc, to.NewElement()), v, setup)
+		conv, err := fromValue(protoops.EnumRef(desc.Enum(), protoops.InterfaceOfListItem(desc, to.NewElement())), v, setup)
 		if err != nil {
 			if setup.clearInvalidSourceValues {
 				from.Values = removeSliceIndex(from.GetValues(), i)
@@ -0,0 +1,363 @@
+package patchstructpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestFromValueConverterEnum(t *testing.T) {
+	t.Parallel()
+
+	mismatch := func(path string) error {
+		return protopatch.NewErrInPath(path, protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType})
+	}
+	tests := []struct {
+		name    string
+		base    proto.Message
+		path    string
+		op      protopatch.OpKind
+		value   any
+		opts    []patchstructpb.Option
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name:  "name",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: "ENUM_VALUE_OTHER",
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+		},
+		{
+			name:  "name-in-value",
+			base:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+			path:  "enum",
+			value: structpb.NewStringValue("ENUM_VALUE_UNSPECIFIED"),
+			want:  &protopatchv1.TestMessage{},
+		},
+		{
+			name:  "number",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: float64(1),
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+		},
+		{
+			name:  "number-string",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: "1",
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+		},
+		{
+			name:  "unknown-number",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: float64(7),
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum(7)},
+		},
+		{
+			name:    "unknown-number-rejected",
+			base:    &protopatchv1.TestMessage{},
+			path:    "enum",
+			value:   float64(7),
+			opts:    []patchstructpb.Option{patchstructpb.RejectUnknownEnumNumbers()},
+			wantErr: mismatch("enum"),
+		},
+		{
+			name:    "fractional-number",
+			base:    &protopatchv1.TestMessage{},
+			path:    "enum",
+			value:   float64(1.5),
+			wantErr: mismatch("enum"),
+		},
+		{
+			name:    "unknown-name",
+			base:    &protopatchv1.TestMessage{},
+			path:    "enum",
+			value:   "ENUM_VALUE_UNKNOWN",
+			wantErr: mismatch("enum"),
+		},
+		{
+			name:  "list-item",
+			base:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+			path:  "enum.0",
+			value: "ENUM_VALUE_OTHER",
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER}},
+		},
+		{
+			name:  "list",
+			base:  &protopatchv1.TestList{},
+			path:  "enum",
+			value: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("ENUM_VALUE_OTHER"), structpb.NewNumberValue(0)}}),
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER, protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+		},
+		{
+			name:  "append-name",
+			base:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+			path:  "enum",
+			op:    protopatch.OpAppend,
+			value: "ENUM_VALUE_OTHER",
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED, protopatchv1.Enum_ENUM_VALUE_OTHER}},
+		},
+		{
+			name:  "append-number",
+			base:  &protopatchv1.TestList{},
+			path:  "enum",
+			op:    protopatch.OpAppend,
+			value: float64(1),
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER}},
+		},
+		{
+			name:  "insert-name",
+			base:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+			path:  "enum.0",
+			op:    protopatch.OpInsert,
+			value: "ENUM_VALUE_OTHER",
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER, protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+		},
+		{
+			name:  "insert-number",
+			base:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+			path:  "enum.0",
+			op:    protopatch.OpInsert,
+			value: "1",
+			want:  &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER, protopatchv1.Enum_ENUM_VALUE_UNSPECIFIED}},
+		},
+		{
+			name:  "enum-number",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: protoreflect.EnumNumber(7),
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum(7)},
+		},
+		{
+			name:    "enum-number-rejected",
+			base:    &protopatchv1.TestMessage{},
+			path:    "enum",
+			value:   protoreflect.EnumNumber(7),
+			opts:    []patchstructpb.Option{patchstructpb.RejectUnknownEnumNumbers()},
+			wantErr: protopatch.NewErrInPath("enum", protopatch.ErrUnknownEnumNumber{Enum: "protopatch.v1.Enum", Number: 7}),
+		},
+		{
+			name:    "enum-value-rejected",
+			base:    &protopatchv1.TestList{},
+			path:    "enum",
+			op:      protopatch.OpAppend,
+			value:   protopatchv1.Enum(7),
+			opts:    []patchstructpb.Option{patchstructpb.RejectUnknownEnumNumbers()},
+			wantErr: protopatch.NewErrInPath("enum.*", protopatch.ErrUnknownEnumNumber{Enum: "protopatch.v1.Enum", Number: 7}),
+		},
+		{
+			name:  "map-value",
+			base:  &protopatchv1.TestMap{},
+			path:  "stringToEnum.a",
+			value: "ENUM_VALUE_OTHER",
+			want:  &protopatchv1.TestMap{StringToEnum: map[string]protopatchv1.Enum{"a": protopatchv1.Enum_ENUM_VALUE_OTHER}},
+		},
+		{
+			name: "message",
+			base: &protopatchv1.TestMessage{},
+			path: "message",
+			value: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"enum": structpb.NewStringValue("ENUM_VALUE_OTHER"),
+				"map":  structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"stringToEnum": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNumberValue(1)}})}}),
+			}},
+			want: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{
+				Enum: protopatchv1.Enum_ENUM_VALUE_OTHER,
+				Map:  &protopatchv1.TestMap{StringToEnum: map[string]protopatchv1.Enum{"a": protopatchv1.Enum_ENUM_VALUE_OTHER}},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(test.base)
+			opt := protopatch.WithConversion(patchstructpb.FromValueConverter(test.opts...))
+			var err error
+			switch test.op {
+			case protopatch.OpAppend:
+				err = protopatch.Append(msg, test.path, test.value, opt)
+			case protopatch.OpInsert:
+				err = protopatch.Insert(msg, test.path, test.value, opt)
+			default:
+				err = protopatch.Set(msg, test.path, test.value, opt)
+			}
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				patchtest.RequireEqual(t, test.base, msg, "message modified by failed operation")
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, msg, "message after set mismatch")
+		})
+	}
+}
+
+func TestConvertFromValueEnum(t *testing.T) {
+	t.Parallel()
+
+	ref, err := protopatch.MessageContainer(&protopatchv1.TestMessage{}).GetNew("enum")
+	require.NoError(t, err)
+	require.Equal(t, protoreflect.EnumNumber(0), ref)
+
+	got, err := patchstructpb.ConvertFromValue(protopatchv1.Enum(0), "ENUM_VALUE_OTHER")
+	require.NoError(t, err)
+	require.Equal(t, protoreflect.EnumNumber(1), got)
+
+	_, err = patchstructpb.ConvertFromValue(protopatchv1.Enum(0), float64(7), patchstructpb.RejectUnknownEnumNumbers())
+	require.Equal(t, protopatch.ErrNoConversionDefined, err)
+
+	conv, ok := patchstructpb.FromValueConverter().(protopatch.EnumConverter)
+	require.True(t, ok, "converter does not implement protopatch.EnumConverter")
+	got, err = conv.ConvertEnum(protopatchv1.Enum(0).Descriptor(), "ENUM_VALUE_OTHER")
+	require.NoError(t, err)
+	require.Equal(t, protoreflect.EnumNumber(1), got)
+}
+
+func TestFromValueConverterBase64Bytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		base    proto.Message
+		path    string
+		value   any
+		opts    []patchstructpb.Option
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name:  "raw",
+			base:  &protopatchv1.TestMessage{},
+			path:  "bytes",
+			value: "/w==",
+			want:  &protopatchv1.TestMessage{Bytes: []byte("/w==")},
+		},
+		{
+			name:  "standard",
+			base:  &protopatchv1.TestMessage{},
+			path:  "bytes",
+			value: "/+8=",
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestMessage{Bytes: []byte{0xff, 0xef}},
+		},
+		{
+			name:  "standard-without-padding",
+			base:  &protopatchv1.TestMessage{},
+			path:  "bytes",
+			value: "/+8",
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestMessage{Bytes: []byte{0xff, 0xef}},
+		},
+		{
+			name:  "url-safe",
+			base:  &protopatchv1.TestMessage{},
+			path:  "bytes",
+			value: "_-8=",
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestMessage{Bytes: []byte{0xff, 0xef}},
+		},
+		{
+			name:  "url-safe-without-padding",
+			base:  &protopatchv1.TestMessage{},
+			path:  "bytes",
+			value: "_-8",
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestMessage{Bytes: []byte{0xff, 0xef}},
+		},
+		{
+			name:    "invalid",
+			base:    &protopatchv1.TestMessage{},
+			path:    "bytes",
+			value:   "!",
+			opts:    []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			wantErr: protopatch.NewErrInPath("bytes", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
+		},
+		{
+			name:  "list",
+			base:  &protopatchv1.TestList{},
+			path:  "bytes",
+			value: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("YQ=="), structpb.NewStringValue("Yg")}}),
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestList{Bytes: [][]byte{[]byte("a"), []byte("b")}},
+		},
+		{
+			name:  "list-item",
+			base:  &protopatchv1.TestList{Bytes: [][]byte{nil}},
+			path:  "bytes.0",
+			value: "YQ==",
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestList{Bytes: [][]byte{[]byte("a")}},
+		},
+		{
+			name:  "map-value",
+			base:  &protopatchv1.TestMap{},
+			path:  "stringToBytes.a",
+			value: "YQ==",
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestMap{StringToBytes: map[string][]byte{"a": []byte("a")}},
+		},
+		{
+			name:  "map",
+			base:  &protopatchv1.TestMap{},
+			path:  "stringToBytes",
+			value: &structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("YQ")}},
+			opts:  []patchstructpb.Option{patchstructpb.Base64Bytes()},
+			want:  &protopatchv1.TestMap{StringToBytes: map[string][]byte{"a": []byte("a")}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(test.base)
+			err := protopatch.Set(msg, test.path, test.value, protopatch.WithConversion(patchstructpb.FromValueConverter(test.opts...)))
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				patchtest.RequireEqual(t, test.base, msg, "message modified by failed operation")
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, msg, "message after set mismatch")
+		})
+	}
+}
+
+func TestToValueConverterBase64Bytes(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestMessage{
+		Bytes: []byte{0xff, 0xef},
+		List:  &protopatchv1.TestList{Bytes: [][]byte{[]byte("a")}},
+		Map:   &protopatchv1.TestMap{StringToBytes: map[string][]byte{"a": []byte("a")}},
+	}
+
+	got, err := patchstructpb.ConvertToValue(&structpb.Value{}, msg, patchstructpb.Base64Bytes())
+	require.NoError(t, err)
+	want := structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+		"bytes": structpb.NewStringValue("/+8="),
+		"list":  structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"bytes": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("YQ==")}})}}),
+		"map":   structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"stringToBytes": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("YQ==")}})}}),
+	}})
+	patchtest.RequireEqual(t, want, got.(proto.Message), "converted value mismatch")
+
+	got, err = patchstructpb.ConvertToValue("", []byte{0xff, 0xef})
+	require.NoError(t, err)
+	require.Equal(t, string([]byte{0xff, 0xef}), got)
+
+	// round trip
+	conv, err := patchstructpb.ConvertFromValue([]byte(nil), "/+8=", patchstructpb.Base64Bytes())
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0xef}, conv)
+}
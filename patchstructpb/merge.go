@@ -0,0 +1,137 @@
+package patchstructpb
+
+import (
+	"errors"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/protoops"
+)
+
+// MergePatch applies the provided JSON Merge Patch (RFC 7396) to the base message. Each key of the patch structure names a field of the message. Null values clear the field, objects provided for singular message fields are merged recursively into the (possibly unset) field value and all other values replace the field value wholesale, including lists and maps. Fields of type google.protobuf.Struct and google.protobuf.Value holding a structure are merged recursively following JSON Merge Patch rules for plain JSON objects.
+//
+// Values are converted to field types with ConvertFromValue semantic, so options controlling handling of unknown keys and invalid values (like IgnoreUnknownKeys or ClearInvalidValues) apply to both the patch structure itself and values nested in it. The merge is then applied as a protopatch patch with protopatch options provided with PatchOptions (so for example path policies and field behavior guards apply) and it is always rolled back on failure, so the base message is modified only when the whole merge succeeds. Failures are reported with protopatch.ErrInPath error pointing to the failing key.
+func MergePatch(base proto.Message, patch *structpb.Struct, opts ...Option) error {
+	pr := protoops.ProtoreflectOfMessage(base)
+	if pr == nil || !pr.IsValid() {
+		return protopatch.ErrMutationOfReadOnlyValue
+	}
+	setup := newSetup(opts...)
+	ops, err := mergeMessage(nil, "", pr, patch, setup)
+	if err != nil {
+		return err
+	}
+	patchOpts := append(setup.patchOptions[:len(setup.patchOptions):len(setup.patchOptions)], protopatch.WithRollback())
+	err = protopatch.Apply(base, ops, patchOpts...)
+	if inOp := (protopatch.ErrInOperation{}); errors.As(err, &inOp) {
+		return inOp.Cause
+	}
+	return err
+}
+
+// mergeMessage appends operations merging the patch structure into the message pointed by the given path.
+func mergeMessage(ops protopatch.Patch, path protopatch.Path, pr protoreflect.Message, patch *structpb.Struct, setup *setup) (protopatch.Patch, error) {
+	desc := pr.Descriptor()
+	for _, k := range sortedKeys(patch) { // deterministic order for deterministic failures
+		v := patch.GetFields()[k]
+		f := protoops.FieldDescriptorInMessageDescriptor(desc, k)
+		if f == nil {
+			if setup.clearUnknownSourceStructKeys {
+				delete(patch.GetFields(), k)
+				continue
+			}
+			if setup.ignoreUnknownStructKeysForMessages {
+				continue
+			}
+			return nil, protopatch.NewErrInPath(k, protopatch.ErrNotFound{Kind: "field", Value: k})
+		}
+		fieldOps, err := mergeField(path.JoinKeys(f.JSONName()), pr, f, v, setup)
+		if err != nil {
+			if setup.clearInvalidSourceValues {
+				delete(patch.GetFields(), k)
+				continue
+			}
+			if setup.ignoreInvalidValues {
+				continue
+			}
+			return nil, protopatch.NewErrInPath(k, err)
+		}
+		ops = append(ops, fieldOps...)
+	}
+	return ops, nil
+}
+
+// mergeField returns operations merging the value into the given field. The path points to the field.
+func mergeField(path protopatch.Path, pr protoreflect.Message, f protoreflect.FieldDescriptor, v *structpb.Value, setup *setup) (protopatch.Patch, error) {
+	if _, ok := v.GetKind().(*structpb.Value_NullValue); ok || v.GetKind() == nil {
+		return protopatch.Patch{{Op: protopatch.OpClear, Path: string(path)}}, nil
+	}
+	s, isStruct := v.GetKind().(*structpb.Value_StructValue)
+	if isStruct && !f.IsList() && !f.IsMap() && f.Kind() == protoreflect.MessageKind {
+		switch f.Message() {
+		case (*structpb.Struct)(nil).ProtoReflect().Descriptor():
+			dst := &structpb.Struct{}
+			proto.Merge(dst, pr.Get(f).Message().Interface())
+			mergeStruct(dst, s.StructValue)
+			return protopatch.Patch{{Op: protopatch.OpSet, Path: string(path), Value: dst}}, nil
+		case (*structpb.Value)(nil).ProtoReflect().Descriptor():
+			dst := &structpb.Value{}
+			proto.Merge(dst, pr.Get(f).Message().Interface())
+			if dst.GetStructValue() == nil {
+				dst.Kind = &structpb.Value_StructValue{StructValue: &structpb.Struct{}}
+			}
+			mergeStruct(dst.GetStructValue(), s.StructValue)
+			return protopatch.Patch{{Op: protopatch.OpSet, Path: string(path), Value: dst}}, nil
+		default:
+			ops := protopatch.Patch(nil)
+			if !pr.Has(f) { // unset message is read-only; create it first
+				ops = append(ops, protopatch.Operation{Op: protopatch.OpSet, Path: string(path), Value: pr.Get(f).Message().Type().New().Interface()})
+			}
+			return mergeMessage(ops, path, pr.Get(f).Message(), s.StructValue, setup)
+		}
+	}
+	conv, err := fromValue(protoops.EnumRef(f.Enum(), protoops.InterfaceOfMessageField(f, pr.NewField(f))), v, setup)
+	if err != nil {
+		return nil, err
+	}
+	if err := protoops.SetMessageField(pr.Type().New(), f, conv); err != nil { // check the value, as the patch is applied later
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	return protopatch.Patch{{Op: protopatch.OpSet, Path: string(path), Value: conv}}, nil
+}
+
+// mergeStruct merges JSON objects according to JSON Merge Patch rules.
+func mergeStruct(dst, patch *structpb.Struct) {
+	if dst.Fields == nil {
+		dst.Fields = map[string]*structpb.Value{}
+	}
+	for k, v := range patch.GetFields() {
+		switch kind := v.GetKind().(type) {
+		case *structpb.Value_NullValue, nil:
+			delete(dst.Fields, k)
+		case *structpb.Value_StructValue:
+			if s := dst.Fields[k].GetStructValue(); s != nil {
+				mergeStruct(s, kind.StructValue)
+				continue
+			}
+			s := &structpb.Struct{}
+			mergeStruct(s, kind.StructValue) // nulls within newly added objects are dropped
+			dst.Fields[k] = structpb.NewStructValue(s)
+		default:
+			dst.Fields[k] = v
+		}
+	}
+}
+
+func sortedKeys(s *structpb.Struct) []string {
+	keys := make([]string, 0, len(s.GetFields()))
+	for k := range s.GetFields() {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,155 @@
+package patchstructpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestMergePatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		base    proto.Message
+		patch   string
+		opts    []patchstructpb.Option
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name:  "empty",
+			base:  &protopatchv1.TestMessage{String_: "aaa"},
+			patch: `{}`,
+			want:  &protopatchv1.TestMessage{String_: "aaa"},
+		},
+		{
+			name:  "scalars",
+			base:  &protopatchv1.TestMessage{String_: "aaa", Int32: 1, Bool: true},
+			patch: `{"string": "bbb", "int64": "5", "bool": null}`,
+			want:  &protopatchv1.TestMessage{String_: "bbb", Int32: 1, Int64: 5},
+		},
+		{
+			name:  "nested-message",
+			base:  &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "aaa", Int32: 1}},
+			patch: `{"message": {"string": "bbb", "int32": null, "message": {"bool": true}}}`,
+			want:  &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "bbb", Message: &protopatchv1.TestMessage{Bool: true}}},
+		},
+		{
+			name:  "clear-message",
+			base:  &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "aaa"}},
+			patch: `{"message": null}`,
+			want:  &protopatchv1.TestMessage{String_: "aaa"},
+		},
+		{
+			name: "list-and-map-replaced",
+			base: &protopatchv1.TestMessage{
+				List: &protopatchv1.TestList{String_: []string{"a", "b"}, Int32: []int32{1}},
+				Map:  &protopatchv1.TestMap{StringToString: map[string]string{"a": "a", "b": "b"}},
+			},
+			patch: `{"list": {"string": ["c"]}, "map": {"stringToString": {"c": "c"}}}`,
+			want: &protopatchv1.TestMessage{
+				List: &protopatchv1.TestList{String_: []string{"c"}, Int32: []int32{1}},
+				Map:  &protopatchv1.TestMap{StringToString: map[string]string{"c": "c"}},
+			},
+		},
+		{
+			name: "struct-merged",
+			base: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Struct: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"a": structpb.NewStringValue("a"),
+					"b": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"c": structpb.NewStringValue("c")}}),
+				}},
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("a")}}),
+			}},
+			patch: `{"wellKnown": {"struct": {"a": null, "b": {"d": "d"}, "e": {"f": null}}, "value": {"g": "g"}}}`,
+			want: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Struct: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"b": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"c": structpb.NewStringValue("c"), "d": structpb.NewStringValue("d")}}),
+					"e": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{}}),
+				}},
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("a"), "g": structpb.NewStringValue("g")}}),
+			}},
+		},
+		{
+			name:  "value-replaced-by-struct",
+			base:  &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{Value: structpb.NewStringValue("a")}},
+			patch: `{"wellKnown": {"value": {"b": "b"}}}`,
+			want: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"b": structpb.NewStringValue("b")}}),
+			}},
+		},
+		{
+			name:    "unknown-key",
+			base:    &protopatchv1.TestMessage{},
+			patch:   `{"message": {"unknown": 1}}`,
+			wantErr: protopatch.NewErrInPath("message.unknown", protopatch.ErrNotFound{Kind: "field", Value: "unknown"}),
+		},
+		{
+			name:  "unknown-key-ignored",
+			base:  &protopatchv1.TestMessage{},
+			patch: `{"message": {"unknown": 1, "string": "aaa"}}`,
+			opts:  []patchstructpb.Option{patchstructpb.IgnoreUnknownKeys()},
+			want:  &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "aaa"}},
+		},
+		{
+			name:    "invalid-value",
+			base:    &protopatchv1.TestMessage{},
+			patch:   `{"int32": true}`,
+			wantErr: protopatch.NewErrInPath("int32", protopatch.ErrNoConversionDefined),
+		},
+		{
+			name:  "invalid-value-cleared",
+			base:  &protopatchv1.TestMessage{},
+			patch: `{"int32": true, "string": "aaa"}`,
+			opts:  []patchstructpb.Option{patchstructpb.ClearInvalidValues()},
+			want:  &protopatchv1.TestMessage{String_: "aaa"},
+		},
+		{
+			name:    "invalid-value-after-valid-ones",
+			base:    &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "aaa"}},
+			patch:   `{"bool": true, "message": {"string": "bbb"}, "string": null, "uint32": -1}`,
+			wantErr: protopatch.NewErrInPath("uint32", protopatch.ErrNoConversionDefined),
+		},
+		{
+			name:    "forbidden-path-after-allowed-ones",
+			base:    &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "aaa"}},
+			patch:   `{"bool": true, "message": {"string": "bbb"}, "string": "bbb"}`,
+			opts:    []patchstructpb.Option{patchstructpb.PatchOptions(protopatch.WithPathPolicy(protopatch.DenyPaths("string")))},
+			wantErr: protopatch.NewErrInPath("string", protopatch.ErrPathForbidden{Path: "string"}),
+		},
+		{
+			name:  "patch-options",
+			base:  &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{}},
+			patch: `{"int32": 5, "message": {"string": "bbb"}}`,
+			opts:  []patchstructpb.Option{patchstructpb.PatchOptions(protopatch.WithPathPolicy(protopatch.AllowPaths("int32", "message.string")))},
+			want:  &protopatchv1.TestMessage{String_: "aaa", Int32: 5, Message: &protopatchv1.TestMessage{String_: "bbb"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			patch := &structpb.Struct{}
+			require.NoError(t, protojson.Unmarshal([]byte(test.patch), patch))
+			base := proto.Clone(test.base)
+			err := patchstructpb.MergePatch(base, patch, test.opts...)
+
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				patchtest.RequireEqual(t, test.base, base, "base modified by failing merge patch")
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, base, "message after merge patch mismatch")
+		})
+	}
+}
@@ -1,5 +1,9 @@
 package patchstructpb
 
+import (
+	"github.com/daishe/protopatch"
+)
+
 type Option interface {
 	configure(*setup)
 }
@@ -44,12 +48,36 @@ func ClearInvalidValues() Option {
 	})
 }
 
+// RejectUnknownEnumNumbers returns option that makes conversion of numbers to values of open enums fail for numbers not defined within the enum. Values of closed enums are always required to be defined within the enum. Enum numbers and enum values that are not defined within the enum fail with protopatch.ErrUnknownEnumNumber error.
+func RejectUnknownEnumNumbers() Option {
+	return optionFunc(func(s *setup) {
+		s.rejectUnknownEnumNumbers = true
+	})
+}
+
+// Base64Bytes returns option that makes bytes values represented as base64 strings, following protojson rules. Strings are decoded using standard or URL-safe base64 encoding, with or without padding, and bytes are encoded using standard base64 encoding with padding. Without this option bytes are represented as strings holding the raw bytes.
+func Base64Bytes() Option {
+	return optionFunc(func(s *setup) {
+		s.base64Bytes = true
+	})
+}
+
+// PatchOptions returns option providing protopatch options used to apply merge patches (see MergePatch), for example path policies, field behavior guards or additional converters.
+func PatchOptions(opts ...protopatch.Option) Option {
+	return optionFunc(func(s *setup) {
+		s.patchOptions = append(s.patchOptions[:len(s.patchOptions):len(s.patchOptions)], opts...)
+	})
+}
+
 type setup struct {
 	ignoreUnknownStructKeysForMessages bool
 	ignoreUnknownStructKeysForMaps     bool
 	clearUnknownSourceStructKeys       bool
 	ignoreInvalidValues                bool
 	clearInvalidSourceValues           bool
+	rejectUnknownEnumNumbers           bool
+	base64Bytes                        bool
+	patchOptions                       []protopatch.Option
 	// convertFromInterface               bool
 }
 
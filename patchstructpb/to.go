@@ -1,6 +1,7 @@
 package patchstructpb
 
 import (
+	"encoding/base64"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -113,6 +114,9 @@ func toValue(from any, setup *setup) (*structpb.Value, error) {
 	case string:
 		return structpb.NewStringValue(v), nil
 	case []byte:
+		if setup.base64Bytes {
+			return structpb.NewStringValue(base64.StdEncoding.EncodeToString(v)), nil
+		}
 		return structpb.NewStringValue(string(v)), nil
 	case proto.Message:
 		return convertMessageToValue(v, setup)
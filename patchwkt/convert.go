@@ -0,0 +1,174 @@
+// Package patchwkt allows google.protobuf.Timestamp and google.protobuf.Duration messages to be set from Go time values and human readable strings.
+package patchwkt
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/daishe/protopatch"
+)
+
+var errDurationOverflow = errors.New("duration out of range of time.Duration")
+
+const (
+	timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationName  protoreflect.FullName = "google.protobuf.Duration"
+)
+
+// ErrInvalidValue is returned when the value cannot be converted to google.protobuf.Timestamp or google.protobuf.Duration, because it is malformed or out of the range allowed for the message (see protojson).
+type ErrInvalidValue struct {
+	Type  protoreflect.FullName
+	Value any
+	Cause error
+}
+
+func (e ErrInvalidValue) Error() string {
+	return fmt.Sprintf("invalid %s value %q: %s", e.Type, fmt.Sprint(e.Value), e.Cause.Error())
+}
+
+func (e ErrInvalidValue) Unwrap() error {
+	return e.Cause
+}
+
+// WellKnownConverter returns a converter that converts time.Time values and RFC 3339 strings (for example "2006-01-02T15:04:05Z") into google.protobuf.Timestamp messages and time.Duration values and duration strings (either Go duration strings like "1h30m" or JSON duration strings like "1.5s") into google.protobuf.Duration messages. It also converts google.protobuf.Timestamp and google.protobuf.Duration messages back into time.Time and time.Duration values.
+func WellKnownConverter() protopatch.Converter {
+	return protopatch.ConverterFunc(ConvertWellKnown)
+}
+
+// ConvertWellKnown converts the given value into google.protobuf.Timestamp or google.protobuf.Duration message of the same type as the to value, or into time.Time or time.Duration value from the given message (see WellKnownConverter). Converted messages are validated with the same range rules as in protojson. It returns ErrNoConversionDefined error when conversion between the given types is not supported.
+func ConvertWellKnown(to, from any) (any, error) {
+	if m, ok := to.(proto.Message); ok {
+		switch m.ProtoReflect().Descriptor().FullName() {
+		case timestampName:
+			return toTimestamp(m.ProtoReflect(), from)
+		case durationName:
+			return toDuration(m.ProtoReflect(), from)
+		}
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	m, ok := from.(proto.Message)
+	if !ok {
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	switch to.(type) {
+	case time.Time:
+		if m.ProtoReflect().Descriptor().FullName() == timestampName {
+			return fromTimestamp(m.ProtoReflect())
+		}
+	case time.Duration:
+		if m.ProtoReflect().Descriptor().FullName() == durationName {
+			return fromDuration(m.ProtoReflect())
+		}
+	}
+	return nil, protopatch.ErrNoConversionDefined
+}
+
+func toTimestamp(to protoreflect.Message, from any) (any, error) {
+	var t time.Time
+	switch v := from.(type) {
+	case time.Time:
+		t = v
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, ErrInvalidValue{Type: timestampName, Value: from, Cause: err}
+		}
+		t = parsed
+	default:
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	ts := timestamppb.New(t)
+	if err := ts.CheckValid(); err != nil {
+		return nil, ErrInvalidValue{Type: timestampName, Value: from, Cause: err}
+	}
+	return secondsAndNanos(to, ts.GetSeconds(), ts.GetNanos()), nil
+}
+
+func toDuration(to protoreflect.Message, from any) (any, error) {
+	var d *durationpb.Duration
+	switch v := from.(type) {
+	case time.Duration:
+		d = durationpb.New(v)
+	case string:
+		parsed, err := parseDuration(v)
+		if err != nil {
+			return nil, ErrInvalidValue{Type: durationName, Value: from, Cause: err}
+		}
+		d = parsed
+	default:
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	if err := d.CheckValid(); err != nil {
+		return nil, ErrInvalidValue{Type: durationName, Value: from, Cause: err}
+	}
+	return secondsAndNanos(to, d.GetSeconds(), d.GetNanos()), nil
+}
+
+// secondsAndNanos returns a new message of the same type as the given google.protobuf.Timestamp or google.protobuf.Duration message with the given seconds and nanos.
+func secondsAndNanos(typ protoreflect.Message, seconds int64, nanos int32) proto.Message {
+	m := typ.New()
+	fields := m.Descriptor().Fields()
+	if seconds != 0 {
+		m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+	}
+	if nanos != 0 {
+		m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(nanos))
+	}
+	return m.Interface()
+}
+
+// jsonDuration matches durations in the JSON format (see protojson), which cover the whole range of google.protobuf.Duration, unlike Go duration strings.
+var jsonDuration = regexp.MustCompile(`^(-)?([0-9]+)(?:\.([0-9]{1,9}))?s$`)
+
+func parseDuration(s string) (*durationpb.Duration, error) {
+	if m := jsonDuration.FindStringSubmatch(s); m != nil {
+		seconds, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		nanos := int64(0)
+		if m[3] != "" {
+			nanos, _ = strconv.ParseInt(m[3]+strings.Repeat("0", 9-len(m[3])), 10, 32)
+		}
+		if m[1] != "" {
+			seconds, nanos = -seconds, -nanos
+		}
+		return &durationpb.Duration{Seconds: seconds, Nanos: int32(nanos)}, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, err
+	}
+	return durationpb.New(d), nil
+}
+
+func fromTimestamp(m protoreflect.Message) (any, error) {
+	fields := m.Descriptor().Fields()
+	ts := &timestamppb.Timestamp{Seconds: m.Get(fields.ByName("seconds")).Int(), Nanos: int32(m.Get(fields.ByName("nanos")).Int())}
+	if err := ts.CheckValid(); err != nil {
+		return nil, ErrInvalidValue{Type: timestampName, Value: ts, Cause: err}
+	}
+	return ts.AsTime(), nil
+}
+
+func fromDuration(m protoreflect.Message) (any, error) {
+	fields := m.Descriptor().Fields()
+	d := &durationpb.Duration{Seconds: m.Get(fields.ByName("seconds")).Int(), Nanos: int32(m.Get(fields.ByName("nanos")).Int())}
+	if err := d.CheckValid(); err != nil {
+		return nil, ErrInvalidValue{Type: durationName, Value: d, Cause: err}
+	}
+	v := d.AsDuration()
+	if back := durationpb.New(v); back.GetSeconds() != d.GetSeconds() || back.GetNanos() != d.GetNanos() { // AsDuration saturates on overflow
+		return nil, ErrInvalidValue{Type: durationName, Value: d, Cause: errDurationOverflow}
+	}
+	return v, nil
+}
@@ -0,0 +1,154 @@
+package patchwkt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchwkt"
+)
+
+func TestWellKnownConverter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		path    string
+		value   any
+		want    *protopatchv1.TestWellKnown
+		wantErr bool
+	}{
+		{
+			name:  "timestamp/time",
+			path:  "timestamp",
+			value: time.Date(2024, 5, 6, 7, 8, 9, 10, time.UTC),
+			want:  &protopatchv1.TestWellKnown{Timestamp: &timestamppb.Timestamp{Seconds: 1714979289, Nanos: 10}},
+		},
+		{
+			name:  "timestamp/rfc3339",
+			path:  "timestamp",
+			value: "2024-05-06T07:08:09Z",
+			want:  &protopatchv1.TestWellKnown{Timestamp: &timestamppb.Timestamp{Seconds: 1714979289}},
+		},
+		{
+			name:  "timestamp/rfc3339-with-offset-and-fraction",
+			path:  "timestamp",
+			value: "2024-05-06T09:08:09.5+02:00",
+			want:  &protopatchv1.TestWellKnown{Timestamp: &timestamppb.Timestamp{Seconds: 1714979289, Nanos: 500000000}},
+		},
+		{
+			name:  "timestamp/message",
+			path:  "timestamp",
+			value: &timestamppb.Timestamp{Seconds: 1},
+			want:  &protopatchv1.TestWellKnown{Timestamp: &timestamppb.Timestamp{Seconds: 1}},
+		},
+		{
+			name:    "timestamp/malformed",
+			path:    "timestamp",
+			value:   "yesterday",
+			wantErr: true,
+		},
+		{
+			name:    "timestamp/out-of-range",
+			path:    "timestamp",
+			value:   time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+		{
+			name:  "duration/duration",
+			path:  "duration",
+			value: 90*time.Minute + time.Nanosecond,
+			want:  &protopatchv1.TestWellKnown{Duration: &durationpb.Duration{Seconds: 5400, Nanos: 1}},
+		},
+		{
+			name:  "duration/go-string",
+			path:  "duration",
+			value: "1h30m",
+			want:  &protopatchv1.TestWellKnown{Duration: &durationpb.Duration{Seconds: 5400}},
+		},
+		{
+			name:  "duration/negative-go-string",
+			path:  "duration",
+			value: "-1.5ms",
+			want:  &protopatchv1.TestWellKnown{Duration: &durationpb.Duration{Nanos: -1500000}},
+		},
+		{
+			name:  "duration/json-string",
+			path:  "duration",
+			value: "-1.000000002s",
+			want:  &protopatchv1.TestWellKnown{Duration: &durationpb.Duration{Seconds: -1, Nanos: -2}},
+		},
+		{
+			name:  "duration/json-string-beyond-go-range",
+			path:  "duration",
+			value: "315576000000s",
+			want:  &protopatchv1.TestWellKnown{Duration: &durationpb.Duration{Seconds: 315576000000}},
+		},
+		{
+			name:    "duration/json-string-out-of-range",
+			path:    "duration",
+			value:   "315576000001s",
+			wantErr: true,
+		},
+		{
+			name:    "duration/malformed",
+			path:    "duration",
+			value:   "1 hour",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := &protopatchv1.TestWellKnown{}
+			err := protopatch.Set(msg, test.path, test.value, protopatch.WithConversion(patchwkt.WellKnownConverter()))
+			if test.wantErr {
+				var invalid patchwkt.ErrInvalidValue
+				require.ErrorAs(t, err, &invalid)
+				require.Equal(t, test.value, invalid.Value)
+				patchtest.RequireEqual(t, &protopatchv1.TestWellKnown{}, msg, "message modified by failed operation")
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, msg, "patched message differs")
+		})
+	}
+}
+
+func TestConvertWellKnown(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestWellKnown{
+		Timestamp: &timestamppb.Timestamp{Seconds: 1714979289, Nanos: 10},
+		Duration:  &durationpb.Duration{Seconds: 5400},
+	}
+	opt := protopatch.WithConversion(patchwkt.WellKnownConverter())
+
+	ts, err := protopatch.GetAs[time.Time](msg, "timestamp", opt)
+	require.NoError(t, err)
+	require.True(t, time.Date(2024, 5, 6, 7, 8, 9, 10, time.UTC).Equal(ts))
+
+	d, err := protopatch.GetAs[time.Duration](msg, "duration", opt)
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Minute, d)
+
+	_, err = patchwkt.ConvertWellKnown(time.Duration(0), &durationpb.Duration{Seconds: 315576000000})
+	require.ErrorAs(t, err, &patchwkt.ErrInvalidValue{})
+
+	_, err = patchwkt.ConvertWellKnown(&durationpb.Duration{}, 1)
+	require.Equal(t, protopatch.ErrNoConversionDefined, err)
+
+	_, err = patchwkt.ConvertWellKnown(time.Time{}, &durationpb.Duration{})
+	require.Equal(t, protopatch.ErrNoConversionDefined, err)
+
+	require.NoError(t, protopatch.Apply(msg, protopatch.Patch{{Op: protopatch.OpTest, Path: "duration", Value: "1h30m"}}, opt))
+	require.True(t, proto.Equal(&durationpb.Duration{Seconds: 5400}, msg.Duration))
+}
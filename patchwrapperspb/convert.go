@@ -0,0 +1,136 @@
+// Package patchwrapperspb allows wrapper messages (google.protobuf.StringValue, google.protobuf.Int64Value and so on) to be used in place of the scalar values they wrap.
+package patchwrapperspb
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daishe/protopatch"
+)
+
+// WrapperConverter returns a converter that wraps scalar values into wrapper messages of matching types (for example string into wrapperspb.StringValue) and unwraps scalar values from wrapper messages. Numeric values of other Go types are accepted as long as they can be represented exactly by the wrapped type (for example int 5 can be wrapped into wrapperspb.Int64Value, but int -1 cannot be wrapped into wrapperspb.UInt64Value). Wrapper fields can be cleared by setting them to nil, as any other message fields.
+func WrapperConverter() protopatch.Converter {
+	return protopatch.ConverterFunc(ConvertWrapper)
+}
+
+// ConvertWrapper wraps the given scalar value into wrapper message of the same type as the to value, or unwraps scalar value of the same type as the to value from the given wrapper message. Numeric values are converted between Go numeric types when the conversion is lossless. It returns ErrNoConversionDefined error when the to value or the from value is not a wrapper message or when types of the wrapped and scalar values differ and the value cannot be converted.
+func ConvertWrapper(to, from any) (any, error) {
+	if m, ok := to.(proto.Message); ok {
+		return wrap(m.ProtoReflect(), from)
+	}
+	if m, ok := from.(proto.Message); ok {
+		return unwrap(to, m.ProtoReflect())
+	}
+	return nil, protopatch.ErrNoConversionDefined
+}
+
+func wrap(to protoreflect.Message, from any) (any, error) {
+	field := wrappedField(to.Descriptor())
+	if field == nil {
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	from, ok := convertScalar(from, reflect.TypeOf(field.Default().Interface()))
+	if !ok {
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	m := to.New()
+	m.Set(field, protoreflect.ValueOf(from))
+	return m.Interface(), nil
+}
+
+func unwrap(to any, from protoreflect.Message) (any, error) {
+	field := wrappedField(from.Descriptor())
+	if field == nil {
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	v, ok := convertScalar(from.Get(field).Interface(), reflect.TypeOf(to))
+	if !ok {
+		return nil, protopatch.ErrNoConversionDefined
+	}
+	return v, nil
+}
+
+// convertScalar returns the given scalar value as a value of the given type. Numeric values are converted only when the given type represents them exactly, other values must be of the given type already.
+func convertScalar(v any, to reflect.Type) (any, bool) {
+	if reflect.TypeOf(v) == to {
+		return v, true
+	}
+	if to == nil {
+		return nil, false
+	}
+	return convertNumber(reflect.ValueOf(v), to)
+}
+
+// convertNumber converts numeric value to the given numeric type, reporting false when the value is not numeric or cannot be represented exactly.
+func convertNumber(v reflect.Value, to reflect.Type) (any, bool) {
+	f := new(big.Float)
+	switch {
+	case v.CanInt():
+		f.SetInt64(v.Int())
+	case v.CanUint():
+		f.SetUint64(v.Uint())
+	case v.CanFloat() && math.IsNaN(v.Float()): // not representable as big.Float
+		if to.Kind() != reflect.Float32 && to.Kind() != reflect.Float64 {
+			return nil, false
+		}
+		return reflect.ValueOf(math.NaN()).Convert(to).Interface(), true
+	case v.CanFloat():
+		f.SetFloat64(v.Float())
+	default:
+		return nil, false
+	}
+	r := reflect.New(to).Elem()
+	switch to.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, acc := f.Int64()
+		if acc != big.Exact || r.OverflowInt(i) {
+			return nil, false
+		}
+		r.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, acc := f.Uint64()
+		if acc != big.Exact || r.OverflowUint(u) {
+			return nil, false
+		}
+		r.SetUint(u)
+	case reflect.Float32:
+		x, acc := f.Float32()
+		if acc != big.Exact {
+			return nil, false
+		}
+		r.SetFloat(float64(x))
+	case reflect.Float64:
+		x, acc := f.Float64()
+		if acc != big.Exact {
+			return nil, false
+		}
+		r.SetFloat(x)
+	default:
+		return nil, false
+	}
+	return r.Interface(), true
+}
+
+// wrappedField returns the value field of the given wrapper message or nil if the message is not a wrapper message.
+func wrappedField(desc protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	if desc.ParentFile().Package() != "google.protobuf" || !wrapperNames[desc.Name()] {
+		return nil
+	}
+	return desc.Fields().ByName("value")
+}
+
+var wrapperNames = map[protoreflect.Name]bool{
+	"DoubleValue": true,
+	"FloatValue":  true,
+	"Int64Value":  true,
+	"UInt64Value": true,
+	"Int32Value":  true,
+	"UInt32Value": true,
+	"BoolValue":   true,
+	"StringValue": true,
+	"BytesValue":  true,
+}
@@ -0,0 +1,220 @@
+package patchwrapperspb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchwrapperspb"
+)
+
+func TestWrapperConverter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		given   *protopatchv1.TestWellKnown
+		op      protopatch.Operation
+		want    *protopatchv1.TestWellKnown
+		wantErr error
+	}{
+		{
+			name:  "set/double",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "doubleValue", Value: float64(1.5)},
+			want:  &protopatchv1.TestWellKnown{DoubleValue: wrapperspb.Double(1.5)},
+		},
+		{
+			name:  "set/float",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "floatValue", Value: float32(1.5)},
+			want:  &protopatchv1.TestWellKnown{FloatValue: wrapperspb.Float(1.5)},
+		},
+		{
+			name:  "set/int64",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "int64Value", Value: int64(-1)},
+			want:  &protopatchv1.TestWellKnown{Int64Value: wrapperspb.Int64(-1)},
+		},
+		{
+			name:  "set/uint64",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "uint64Value", Value: uint64(1)},
+			want:  &protopatchv1.TestWellKnown{Uint64Value: wrapperspb.UInt64(1)},
+		},
+		{
+			name:  "set/int32",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "int32Value", Value: int32(-1)},
+			want:  &protopatchv1.TestWellKnown{Int32Value: wrapperspb.Int32(-1)},
+		},
+		{
+			name:  "set/uint32",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "uint32Value", Value: uint32(1)},
+			want:  &protopatchv1.TestWellKnown{Uint32Value: wrapperspb.UInt32(1)},
+		},
+		{
+			name:  "set/bool",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "boolValue", Value: true},
+			want:  &protopatchv1.TestWellKnown{BoolValue: wrapperspb.Bool(true)},
+		},
+		{
+			name:  "set/string",
+			given: &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("alice")},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "stringValue", Value: "bob"},
+			want:  &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")},
+		},
+		{
+			name:  "set/bytes",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "bytesValue", Value: []byte("a")},
+			want:  &protopatchv1.TestWellKnown{BytesValue: wrapperspb.Bytes([]byte("a"))},
+		},
+		{
+			name:  "set/zero",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "stringValue", Value: ""},
+			want:  &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("")},
+		},
+		{
+			name:  "set/wrapper",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "stringValue", Value: wrapperspb.String("bob")},
+			want:  &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")},
+		},
+		{
+			name:  "set/value",
+			given: &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("alice")},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "stringValue.value", Value: "bob"},
+			want:  &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")},
+		},
+		{
+			name:    "set/mismatching-type",
+			given:   &protopatchv1.TestWellKnown{},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "int64Value", Value: "1"},
+			wantErr: protopatch.NewErrInPath("int64Value", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
+		},
+		{
+			name:  "set/int64-from-int",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "int64Value", Value: 5},
+			want:  &protopatchv1.TestWellKnown{Int64Value: wrapperspb.Int64(5)},
+		},
+		{
+			name:  "set/uint32-from-int64",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "uint32Value", Value: int64(7)},
+			want:  &protopatchv1.TestWellKnown{Uint32Value: wrapperspb.UInt32(7)},
+		},
+		{
+			name:  "set/double-from-int",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "doubleValue", Value: 3},
+			want:  &protopatchv1.TestWellKnown{DoubleValue: wrapperspb.Double(3)},
+		},
+		{
+			name:  "set/int32-from-integral-float",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "int32Value", Value: float64(-2)},
+			want:  &protopatchv1.TestWellKnown{Int32Value: wrapperspb.Int32(-2)},
+		},
+		{
+			name:  "set/float-from-double",
+			given: &protopatchv1.TestWellKnown{},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "floatValue", Value: float64(0.5)},
+			want:  &protopatchv1.TestWellKnown{FloatValue: wrapperspb.Float(0.5)},
+		},
+		{
+			name:    "set/uint64-from-negative-int",
+			given:   &protopatchv1.TestWellKnown{},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "uint64Value", Value: -1},
+			wantErr: protopatch.NewErrInPath("uint64Value", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
+		},
+		{
+			name:    "set/int32-overflow",
+			given:   &protopatchv1.TestWellKnown{},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "int32Value", Value: int64(1) << 40},
+			wantErr: protopatch.NewErrInPath("int32Value", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
+		},
+		{
+			name:    "set/int64-from-fractional-float",
+			given:   &protopatchv1.TestWellKnown{},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "int64Value", Value: 1.5},
+			wantErr: protopatch.NewErrInPath("int64Value", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
+		},
+		{
+			name:    "set/float-from-inexact-double",
+			given:   &protopatchv1.TestWellKnown{},
+			op:      protopatch.Operation{Op: protopatch.OpSet, Path: "floatValue", Value: 0.1},
+			wantErr: protopatch.NewErrInPath("floatValue", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
+		},
+		{
+			name:  "clear",
+			given: &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")},
+			op:    protopatch.Operation{Op: protopatch.OpSet, Path: "stringValue", Value: nil},
+			want:  &protopatchv1.TestWellKnown{},
+		},
+		{
+			name:  "test",
+			given: &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")},
+			op:    protopatch.Operation{Op: protopatch.OpTest, Path: "stringValue", Value: "bob"},
+			want:  &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")},
+		},
+		{
+			name:    "test/failed",
+			given:   &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")},
+			op:      protopatch.Operation{Op: protopatch.OpTest, Path: "stringValue", Value: "alice"},
+			wantErr: protopatch.NewErrInPath("stringValue", protopatch.ErrTestFailed{Expected: wrapperspb.String("alice"), Actual: wrapperspb.String("bob")}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(test.given)
+			err := protopatch.Apply(msg, protopatch.Patch{test.op}, protopatch.WithConversion(patchwrapperspb.WrapperConverter()))
+			if test.wantErr != nil {
+				require.EqualError(t, err, protopatch.ErrInOperation{Index: 0, Op: test.op.Op, Cause: test.wantErr}.Error())
+				patchtest.RequireEqual(t, test.given, msg, "message modified by failed operation")
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, msg, "patched message differs")
+		})
+	}
+}
+
+func TestConvertWrapper(t *testing.T) {
+	t.Parallel()
+
+	got, err := patchwrapperspb.ConvertWrapper("", wrapperspb.String("bob"))
+	require.NoError(t, err)
+	require.Equal(t, "bob", got)
+
+	_, err = patchwrapperspb.ConvertWrapper(int64(0), wrapperspb.String("bob"))
+	require.Equal(t, protopatch.ErrNoConversionDefined, err)
+
+	_, err = patchwrapperspb.ConvertWrapper(&protopatchv1.TestMessage{}, "bob")
+	require.Equal(t, protopatch.ErrNoConversionDefined, err)
+
+	msg := &protopatchv1.TestWellKnown{Int64Value: wrapperspb.Int64(1)}
+	v, err := protopatch.GetAs[int64](msg, "int64Value", protopatch.WithConversion(patchwrapperspb.WrapperConverter()))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+	i, err := protopatch.GetAs[int](msg, "int64Value", protopatch.WithConversion(patchwrapperspb.WrapperConverter()))
+	require.NoError(t, err)
+	require.Equal(t, 1, i)
+
+	// copy between scalar and wrapper fields
+	msg = &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")}
+	err = protopatch.Copy(msg, "stringValue", "stringValue.value", protopatch.WithConversion(patchwrapperspb.WrapperConverter()))
+	require.NoError(t, err)
+	patchtest.RequireEqual(t, &protopatchv1.TestWellKnown{StringValue: wrapperspb.String("bob")}, msg, "copied message differs")
+}
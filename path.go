@@ -1,9 +1,18 @@
 package protopatch
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 const PathSegmentSeparator = "."
 
+// pathEscape escapes a character within a path segment, so that it is a part of the segment key (for example "example\\.com").
+const pathEscape = "\\"
+
+// pathSpecialCharacters are characters that are escaped within keys by EscapePathSegment.
+const pathSpecialCharacters = PathSegmentSeparator + pathEscape + pathFilterStart + pathFilterEnd
+
 type PathSegment struct {
 	path        Path
 	startOffset int
@@ -11,7 +20,15 @@ type PathSegment struct {
 }
 
 func (ps PathSegment) PrecedingPath() Path {
-	return ps.path[:max(0, ps.startOffset-1)]
+	return ps.path[:ps.precedingEnd()]
+}
+
+// precedingEnd returns offset of the end of the preceding segment. Filter segments attached to the preceding segment are not separated from it.
+func (ps PathSegment) precedingEnd() int {
+	if ps.startOffset > 0 && ps.path[ps.startOffset-1] == PathSegmentSeparator[0] {
+		return ps.startOffset - 1
+	}
+	return ps.startOffset
 }
 
 func (ps PathSegment) PrecedingPathWithCurrentSegment() Path {
@@ -22,12 +39,25 @@ func (ps PathSegment) Previous() PathSegment {
 	if ps.startOffset == 0 {
 		return PathSegment{}
 	}
-	end := ps.startOffset - 1
-	start := strings.LastIndex(string(ps.path[0:end]), PathSegmentSeparator) + 1
-	return PathSegment{path: ps.path, startOffset: start, endOffset: end}
+	end := ps.precedingEnd()
+	if !ps.path.needsTokenizer() {
+		start := strings.LastIndex(string(ps.path[0:end]), PathSegmentSeparator) + 1
+		return PathSegment{path: ps.path, startOffset: start, endOffset: end}
+	}
+	prev := ps.path.First()
+	for prev.endOffset < end {
+		prev = prev.Next()
+	}
+	return prev
 }
 
+// Value returns key held by the segment. Escaped characters and quoted keys (as in `labels["example.com"]`) are unescaped. Filters are returned as they are written.
 func (ps PathSegment) Value() string {
+	return unescapePathSegment(ps.raw())
+}
+
+// raw returns the segment as it is written within the path.
+func (ps PathSegment) raw() string {
 	return string(ps.path[ps.startOffset:ps.endOffset])
 }
 
@@ -35,16 +65,18 @@ func (ps PathSegment) Next() PathSegment {
 	if ps.endOffset == len(ps.path) {
 		return PathSegment{}
 	}
-	start := ps.endOffset + 1
-	end := start + strings.Index(string(ps.path[start:]), PathSegmentSeparator)
-	if end < start {
-		end = len(ps.path)
+	start := ps.endOffset
+	if ps.path[start] == PathSegmentSeparator[0] {
+		start++
 	}
-	return PathSegment{path: ps.path, startOffset: start, endOffset: end}
+	return PathSegment{path: ps.path, startOffset: start, endOffset: ps.path.segmentEnd(start)}
 }
 
 func (ps PathSegment) FollowingPath() Path {
-	return ps.path[min(ps.endOffset+1, len(ps.path)):]
+	if ps.endOffset < len(ps.path) && ps.path[ps.endOffset] == PathSegmentSeparator[0] {
+		return ps.path[ps.endOffset+1:]
+	}
+	return ps.path[ps.endOffset:]
 }
 
 func (ps PathSegment) FollowingPathWithCurrentSegment() Path {
@@ -62,19 +94,63 @@ func (ps PathSegment) IsLast() bool {
 type Path string
 
 func (p Path) First() PathSegment {
-	end := strings.Index(string(p), PathSegmentSeparator)
-	if end < 0 {
-		end = len(p)
-	}
-	return PathSegment{path: p, startOffset: 0, endOffset: end}
+	return PathSegment{path: p, startOffset: 0, endOffset: p.segmentEnd(0)}
 }
 
 func (p Path) Last() PathSegment {
-	return PathSegment{path: p, startOffset: strings.LastIndex(string(p), PathSegmentSeparator) + 1, endOffset: len(p)}
+	if !p.needsTokenizer() {
+		return PathSegment{path: p, startOffset: strings.LastIndex(string(p), PathSegmentSeparator) + 1, endOffset: len(p)}
+	}
+	ps := p.First()
+	for !ps.IsLast() {
+		ps = ps.Next()
+	}
+	return ps
 }
 
 func (p Path) SegmentsCount() int {
-	return strings.Count(string(p), PathSegmentSeparator) + 1
+	if !p.needsTokenizer() {
+		return strings.Count(string(p), PathSegmentSeparator) + 1
+	}
+	count := 0
+	for range p.Iter {
+		count++
+	}
+	return count
+}
+
+// needsTokenizer reports whether the path contains filters, quoted keys or escaped characters, so segments cannot be found by looking for separators only.
+func (p Path) needsTokenizer() bool {
+	return strings.ContainsAny(string(p), pathFilterStart+pathEscape)
+}
+
+// segmentEnd returns offset of the end of the segment starting at the given offset. Escaped separators and separators within square brackets (filters and quoted keys) do not end segments, and square brackets that do not start a segment (for example "users[id=42]") start a new one.
+func (p Path) segmentEnd(start int) int {
+	depth, quoted := 0, false
+	for i := start; i < len(p); i++ {
+		switch ch := p[i]; {
+		case quoted:
+			if ch == '\\' {
+				i++
+			} else if ch == '"' {
+				quoted = false
+			}
+		case ch == pathEscape[0]:
+			i++
+		case ch == '"' && depth > 0:
+			quoted = true
+		case ch == pathFilterStart[0]:
+			if depth == 0 && i > start {
+				return i
+			}
+			depth++
+		case ch == pathFilterEnd[0] && depth > 0:
+			depth--
+		case ch == PathSegmentSeparator[0] && depth == 0:
+			return i
+		}
+	}
+	return len(p)
 }
 
 func (p Path) Segments() []PathSegment {
@@ -109,7 +185,7 @@ func (p Path) Join(other ...Path) Path {
 // JoinSegment create a new Path by concatenating all provided segments at the ent of the give Path.
 func (p Path) JoinSegment(segments ...PathSegment) Path {
 	for _, s := range segments {
-		p += Path(PathSegmentSeparator + s.Value())
+		p += Path(PathSegmentSeparator + s.raw())
 	}
 	return p
 }
@@ -121,3 +197,65 @@ func (p Path) JoinSegmentValue(segments ...string) Path {
 	}
 	return p
 }
+
+// JoinKeys create a new Path by appending all provided keys (field names, list indexes or map keys) at the end of the given Path. Keys are escaped with EscapePathSegment, so they may contain any characters. Unlike JoinSegmentValue, keys appended to an empty path do not start with a separator.
+func (p Path) JoinKeys(keys ...string) Path {
+	for _, k := range keys {
+		if p == "" {
+			p = Path(EscapePathSegment(k))
+			continue
+		}
+		p += Path(PathSegmentSeparator + EscapePathSegment(k))
+	}
+	return p
+}
+
+// NewPath builds a Path from the provided keys (field names, list indexes or map keys), escaping them when necessary.
+func NewPath(keys ...string) Path {
+	return Path("").JoinKeys(keys...)
+}
+
+// EscapePathSegment returns the key escaped so that it forms a single path segment holding exactly that key. Separators, escape characters and square brackets are escaped with a backslash, a key equal to the wildcard is escaped so it is not expanded and an empty key is quoted.
+func EscapePathSegment(key string) string {
+	switch {
+	case key == "":
+		return `[""]`
+	case key == PathWildcard:
+		return pathEscape + key
+	case !strings.ContainsAny(key, pathSpecialCharacters):
+		return key
+	}
+	b := strings.Builder{}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(pathSpecialCharacters, key[i]) >= 0 {
+			b.WriteString(pathEscape)
+		}
+		b.WriteByte(key[i])
+	}
+	return b.String()
+}
+
+// isQuotedPathKey reports whether the segment is a quoted key, as in `["example.com"]`.
+func isQuotedPathKey(segment string) bool {
+	return len(segment) >= 4 && strings.HasPrefix(segment, pathFilterStart+`"`) && strings.HasSuffix(segment, `"`+pathFilterEnd)
+}
+
+func unescapePathSegment(segment string) string {
+	if isQuotedPathKey(segment) {
+		if key, err := strconv.Unquote(segment[1 : len(segment)-1]); err == nil {
+			return key
+		}
+		return segment
+	}
+	if isPathFilter(segment) || !strings.Contains(segment, pathEscape) {
+		return segment
+	}
+	b := strings.Builder{}
+	for i := 0; i < len(segment); i++ {
+		if segment[i] == pathEscape[0] && i+1 < len(segment) {
+			i++
+		}
+		b.WriteByte(segment[i])
+	}
+	return b.String()
+}
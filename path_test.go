@@ -119,6 +119,50 @@ func TestPath(t *testing.T) {
 				{value: "", isLast: true, precedingPath: ".", precedingPathWithCurrentSegment: ".."},
 			},
 		},
+		{
+			path: "a[b.c=1].d",
+			segments: []segmentInfo{
+				{value: "a", isFirst: true, followingPath: "[b.c=1].d", precedingPathWithCurrentSegment: "a", followingPathWithCurrentSegment: "a[b.c=1].d"},
+				{value: "[b.c=1]", precedingPath: "a", followingPath: "d", precedingPathWithCurrentSegment: "a[b.c=1]", followingPathWithCurrentSegment: "[b.c=1].d"},
+				{value: "d", isLast: true, precedingPath: "a[b.c=1]", precedingPathWithCurrentSegment: "a[b.c=1].d", followingPathWithCurrentSegment: "d"},
+			},
+		},
+		{
+			path: "a.[?(@.b==\"x.]\")]",
+			segments: []segmentInfo{
+				{value: "a", isFirst: true, followingPath: "[?(@.b==\"x.]\")]", precedingPathWithCurrentSegment: "a", followingPathWithCurrentSegment: "a.[?(@.b==\"x.]\")]"},
+				{value: "[?(@.b==\"x.]\")]", isLast: true, precedingPath: "a", precedingPathWithCurrentSegment: "a.[?(@.b==\"x.]\")]", followingPathWithCurrentSegment: "[?(@.b==\"x.]\")]"},
+			},
+		},
+		{
+			path: `a\.b.c`,
+			segments: []segmentInfo{
+				{value: "a.b", isFirst: true, followingPath: "c", precedingPathWithCurrentSegment: `a\.b`, followingPathWithCurrentSegment: `a\.b.c`},
+				{value: "c", isLast: true, precedingPath: `a\.b`, precedingPathWithCurrentSegment: `a\.b.c`, followingPathWithCurrentSegment: "c"},
+			},
+		},
+		{
+			path: `a\\.b`,
+			segments: []segmentInfo{
+				{value: `a\`, isFirst: true, followingPath: "b", precedingPathWithCurrentSegment: `a\\`, followingPathWithCurrentSegment: `a\\.b`},
+				{value: "b", isLast: true, precedingPath: `a\\`, precedingPathWithCurrentSegment: `a\\.b`, followingPathWithCurrentSegment: "b"},
+			},
+		},
+		{
+			path: `labels["example.com"].a`,
+			segments: []segmentInfo{
+				{value: "labels", isFirst: true, followingPath: `["example.com"].a`, precedingPathWithCurrentSegment: "labels", followingPathWithCurrentSegment: `labels["example.com"].a`},
+				{value: "example.com", precedingPath: "labels", followingPath: "a", precedingPathWithCurrentSegment: `labels["example.com"]`, followingPathWithCurrentSegment: `["example.com"].a`},
+				{value: "a", isLast: true, precedingPath: `labels["example.com"]`, precedingPathWithCurrentSegment: `labels["example.com"].a`, followingPathWithCurrentSegment: "a"},
+			},
+		},
+		{
+			path: "[a=1][b=2]",
+			segments: []segmentInfo{
+				{value: "[a=1]", isFirst: true, followingPath: "[b=2]", precedingPathWithCurrentSegment: "[a=1]", followingPathWithCurrentSegment: "[a=1][b=2]"},
+				{value: "[b=2]", isLast: true, precedingPath: "[a=1]", precedingPathWithCurrentSegment: "[a=1][b=2]", followingPathWithCurrentSegment: "[b=2]"},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -264,3 +308,57 @@ func TestPathJoin(t *testing.T) {
 		})
 	}
 }
+
+func TestPathBuilder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		keys []string
+		want string
+	}{
+		{
+			name: "empty",
+			keys: []string{},
+			want: "",
+		},
+		{
+			name: "plain-keys",
+			keys: []string{"a", "0", "b"},
+			want: "a.0.b",
+		},
+		{
+			name: "special-characters",
+			keys: []string{"example.com", `a\b`, "[c]"},
+			want: `example\.com.a\\b.\[c\]`,
+		},
+		{
+			name: "empty-key",
+			keys: []string{"a", ""},
+			want: `a.[""]`,
+		},
+		{
+			name: "wildcard-key",
+			keys: []string{"*", "a*"},
+			want: `\*.a*`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := protopatch.NewPath(test.keys...)
+			require.Equal(t, protopatch.Path(test.want), got)
+			require.False(t, got.HasWildcard())
+			if len(test.keys) == 0 {
+				return
+			}
+			values := []string{}
+			for ps := range got.Iter {
+				values = append(values, ps.Value())
+			}
+			require.Equal(t, test.keys, values)
+			require.Equal(t, got.JoinKeys("x.y"), protopatch.NewPath(append(test.keys, "x.y")...))
+		})
+	}
+}
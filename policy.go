@@ -0,0 +1,167 @@
+package protopatch
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daishe/protopatch/internal/protoops"
+)
+
+// PathPolicy decides which values may be accessed by patch operations.
+type PathPolicy interface {
+	// AllowPath reports whether the value pointed by the path, within messages described by the provided descriptor, may be read or modified. Paths passed to AllowPath never contain wildcards. Message fields within them are referred by JSON names, list items by their non-negative indexes and map keys are in their canonical form (as long as the value pointed by the path and its parents can be resolved).
+	AllowPath(desc protoreflect.MessageDescriptor, path Path) bool
+}
+
+// PathPolicyFunc allows to implement PathPolicy interface with a function.
+type PathPolicyFunc func(desc protoreflect.MessageDescriptor, path Path) bool
+
+func (fn PathPolicyFunc) AllowPath(desc protoreflect.MessageDescriptor, path Path) bool {
+	return fn(desc, path)
+}
+
+// WithPathPolicy returns option that makes set, append, insert, clear, copy, move, swap and test operations consult the provided policies for every path they read or modify (for copy, move and swap operations both paths). All policies must allow the path, otherwise the operation fails with ErrPathForbidden error wrapped in ErrInPath error. Paths with wildcards are checked after expansion, for every matching element, before any of them is modified.
+func WithPathPolicy(policies ...PathPolicy) Option {
+	return optionFunc(func(s *setup) {
+		s.policy = policies // TODO: Copy slice to avoid referencing the passed value (?)
+	})
+}
+
+// AllowPaths returns policy allowing only values pointed by paths matching any of the provided patterns, together with everything they contain. Patterns are paths in which the wildcard segment matches any single segment; for example "labels.*" allows every value of the labels map and "users.*.email" allows email field of every item of the users list. Field names and map keys within patterns are normalized in the same way as paths of operations. Patterns are matched against prefixes of paths, so parents of allowed values (including the base message) are not allowed.
+func AllowPaths(patterns ...string) PathPolicy {
+	return PathPolicyFunc(func(desc protoreflect.MessageDescriptor, path Path) bool {
+		for _, pattern := range patterns {
+			if prefix, ok := matchPathPattern(canonicalPattern(desc, Path(pattern)), path); ok && prefix {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// DenyPaths returns policy forbidding values pointed by paths matching any of the provided patterns (see AllowPaths for the pattern syntax), together with everything they contain. Parents of forbidden values are forbidden as well, as reading or modifying them (for example with copy or set operations) reads or modifies the forbidden values too. In particular, the base message itself is forbidden when any pattern is provided.
+func DenyPaths(patterns ...string) PathPolicy {
+	return PathPolicyFunc(func(desc protoreflect.MessageDescriptor, path Path) bool {
+		for _, pattern := range patterns {
+			if _, ok := matchPathPattern(canonicalPattern(desc, Path(pattern)), path); ok {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// matchPathPattern reports whether the pattern and the path match on all of their common segments. Prefix is true when the whole pattern is matched, that is when the pattern matches a prefix of the path (or the whole path).
+func matchPathPattern(pattern, path Path) (prefix bool, ok bool) {
+	if pattern == "" { // pattern refers to the base message
+		return true, true
+	}
+	if path == "" { // path refers to the base message
+		return false, true
+	}
+	segments := path.Segments()
+	i := 0
+	for ps := range pattern.Iter {
+		if i >= len(segments) {
+			return false, true
+		}
+		if ps.raw() != PathWildcard && ps.Value() != segments[i].Value() {
+			return false, false
+		}
+		i++
+	}
+	return true, true
+}
+
+// checkPath returns ErrPathForbidden error wrapped in ErrInPath error if any of the configured policies does not allow the path. Paths with wildcards are not checked.
+func checkPath(base proto.Message, path string, setup *setup) error {
+	if len(setup.policy) == 0 || Path(path).HasWildcard() { // paths with wildcards are checked after expansion
+		return nil
+	}
+	desc := base.ProtoReflect().Descriptor()
+	canonical := canonicalPath(base, Path(path), setup)
+	for _, p := range setup.policy {
+		if !p.AllowPath(desc, canonical) {
+			return NewErrInPath(path, ErrPathForbidden{Path: string(canonical)})
+		}
+	}
+	return nil
+}
+
+// canonicalPath returns the path with message fields referred by JSON names, list items by non-negative indexes and map keys in their canonical form. List items are resolved within the base message, while fields and map keys are resolved with message descriptors, so that paths to values that do not exist yet are normalized as well. Segments that cannot be resolved are left as they are.
+func canonicalPath(base proto.Message, path Path, setup *setup) Path {
+	if path == "" {
+		return path
+	}
+	c, err := transformContainer(MessageContainer(base), setup)
+	if err != nil {
+		c = nil
+	}
+	md, collection := base.ProtoReflect().Descriptor(), protoreflect.FieldDescriptor(nil)
+	keys := []string(nil)
+	for ps := range path.Iter {
+		var key string
+		key, md, collection = canonicalKey(ps, md, collection)
+		if lc, ok := unwrapContainer(c).(*listContainer); ok {
+			if idx, err := lc.index(ps.Value()); err == nil {
+				key = strconv.Itoa(idx)
+			}
+		}
+		keys = append(keys, key)
+		if c != nil {
+			if c, err = accessOnce(c, ps, setup); err != nil {
+				c = nil
+			}
+		}
+	}
+	return NewPath(keys...)
+}
+
+// canonicalPattern works like canonicalPath, but resolves only fields and map keys, leaving wildcard segments as they are.
+func canonicalPattern(desc protoreflect.MessageDescriptor, pattern Path) Path {
+	if pattern == "" {
+		return pattern
+	}
+	md, collection := desc, protoreflect.FieldDescriptor(nil)
+	p := Path("")
+	for ps := range pattern.Iter {
+		if ps.raw() != PathWildcard {
+			var key string
+			key, md, collection = canonicalKey(ps, md, collection)
+			p = p.JoinKeys(key)
+			continue
+		}
+		if ps.IsFirst() {
+			p = PathWildcard
+		} else {
+			p = p.Join(PathWildcard)
+		}
+		if collection == nil { // wildcard matching message fields; following segments cannot be resolved
+			md = nil
+			continue
+		}
+		_, md, collection, _ = compileSegment(ps, nil, collection)
+	}
+	return p
+}
+
+// canonicalKey returns the canonical form of the key of the given segment, resolved within the given message or list / map field, together with the message or list / map field the next segment descends into. If the segment cannot be resolved, the key is returned as it is and both returned descriptors are nil.
+func canonicalKey(ps PathSegment, md protoreflect.MessageDescriptor, collection protoreflect.FieldDescriptor) (string, protoreflect.MessageDescriptor, protoreflect.FieldDescriptor) {
+	key := ps.Value()
+	if md == nil && collection == nil {
+		return key, nil, nil
+	}
+	field, nextMd, nextCollection, err := compileSegment(ps, md, collection)
+	if err != nil {
+		return key, nil, nil
+	}
+	switch {
+	case field != nil:
+		key = field.JSONName()
+	case collection.IsMap():
+		key = protoops.ParseMapKey(collection.MapKey(), key).String()
+	}
+	return key, nextMd, nextCollection
+}
@@ -0,0 +1,130 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestPathPolicy(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestMessage{
+		String_: "a",
+		Message: &protopatchv1.TestMessage{String_: "secret", Int32: 1},
+		List:    &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b"}}},
+		Map:     &protopatchv1.TestMap{Int32ToString: map[int32]string{16: "a"}},
+		WellKnown: &protopatchv1.TestWellKnown{
+			Value: structpb.NewStringValue("a"),
+		},
+	}
+	deny := protopatch.WithPathPolicy(protopatch.DenyPaths("message.string", "well_known", "list.message.*.int32", "map.int32_to_string.16"))
+	allow := protopatch.WithPathPolicy(protopatch.AllowPaths("string", "message.int32", "list.message.*.string", "map"))
+	denyAll := protopatch.WithPathPolicy(protopatch.DenyPaths("*"))
+	denyLeading := protopatch.WithPathPolicy(protopatch.DenyPaths("*.string"))
+	denyTrailing := protopatch.WithPathPolicy(protopatch.DenyPaths("message.*"))
+	allowAll := protopatch.WithPathPolicy(protopatch.AllowPaths("*"))
+	allowLeading := protopatch.WithPathPolicy(protopatch.AllowPaths("*.string"))
+	allowTrailing := protopatch.WithPathPolicy(protopatch.AllowPaths("message.*"))
+	forbidden := func(path, canonical string) error {
+		return protopatch.NewErrInPath(path, protopatch.ErrPathForbidden{Path: canonical})
+	}
+
+	tests := []struct {
+		name    string
+		op      protopatch.Operation
+		opt     protopatch.Option
+		wantErr error
+	}{
+		{name: "deny-unrelated-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "string", Value: "x"}, opt: deny},
+		{name: "deny-sibling-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.int32", Value: int32(2)}, opt: deny},
+		{name: "deny-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.string", Value: "x"}, opt: deny, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-field-by-number", op: protopatch.Operation{Op: protopatch.OpClear, Path: "17.14"}, opt: deny, wantErr: forbidden("17.14", "message.string")},
+		{name: "deny-field-by-json-name", op: protopatch.Operation{Op: protopatch.OpClear, Path: "wellKnown.value"}, opt: deny, wantErr: forbidden("wellKnown.value", "wellKnown.value")},
+		{name: "deny-parent", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message", Value: &protopatchv1.TestMessage{}}, opt: deny, wantErr: forbidden("message", "message")},
+		{name: "deny-base", op: protopatch.Operation{Op: protopatch.OpClear, Path: ""}, opt: deny, wantErr: forbidden("", "")},
+		{name: "deny-copy-source", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "string", From: "message.string"}, opt: deny, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-copy-parent-source", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "message.message", From: "message"}, opt: deny, wantErr: forbidden("message", "message")},
+		{name: "deny-move-source", op: protopatch.Operation{Op: protopatch.OpMove, Path: "string", From: "message.string"}, opt: deny, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-swap-second", op: protopatch.Operation{Op: protopatch.OpSwap, Path: "string", From: "message.string"}, opt: deny, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-test", op: protopatch.Operation{Op: protopatch.OpTest, Path: "message.string", Value: "secret"}, opt: deny, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-list-item-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.message.-1.int32", Value: int32(2)}, opt: deny, wantErr: forbidden("list.message.-1.int32", "list.message.1.int32")},
+		{name: "deny-filtered-list-item-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.message[string=a].int32", Value: int32(2)}, opt: deny, wantErr: forbidden("list.message[string=a].int32", "list.message.0.int32")},
+		{name: "deny-wildcard", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.message.*.int32", Value: int32(2)}, opt: deny, wantErr: forbidden("list.message.0.int32", "list.message.0.int32")},
+		{name: "deny-list-item", op: protopatch.Operation{Op: protopatch.OpInsert, Path: "list.message.0", Value: &protopatchv1.TestMessage{}}, opt: deny, wantErr: forbidden("list.message.0", "list.message.0")},
+		{name: "deny-list-item-sibling-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.message.*.string", Value: "x"}, opt: deny},
+		{name: "deny-map-key", op: protopatch.Operation{Op: protopatch.OpSet, Path: "map.int32ToString.0x10", Value: "x"}, opt: deny, wantErr: forbidden("map.int32ToString.0x10", "map.int32ToString.16")},
+		{name: "deny-other-map-key", op: protopatch.Operation{Op: protopatch.OpSet, Path: "map.int32ToString.1", Value: "x"}, opt: deny},
+		{name: "allow-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "string", Value: "x"}, opt: allow},
+		{name: "allow-nested-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.int32", Value: int32(2)}, opt: allow},
+		{name: "allow-wildcard", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.message.*.string", Value: "x"}, opt: allow},
+		{name: "allow-within-allowed", op: protopatch.Operation{Op: protopatch.OpSet, Path: "map.int32ToString.1", Value: "x"}, opt: allow},
+		{name: "allow-copy", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "string", From: "list.message.0.string"}, opt: allow},
+		{name: "allow-not-allowed-field", op: protopatch.Operation{Op: protopatch.OpSet, Path: "int32", Value: int32(2)}, opt: allow, wantErr: forbidden("int32", "int32")},
+		{name: "allow-parent", op: protopatch.Operation{Op: protopatch.OpClear, Path: "message"}, opt: allow, wantErr: forbidden("message", "message")},
+		{name: "allow-append-to-parent", op: protopatch.Operation{Op: protopatch.OpAppend, Path: "list.message", Value: &protopatchv1.TestMessage{}}, opt: allow, wantErr: forbidden("list.message", "list.message")},
+		{name: "allow-copy-source", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "string", From: "message.string"}, opt: allow, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-all", op: protopatch.Operation{Op: protopatch.OpSet, Path: "string", Value: "x"}, opt: denyAll, wantErr: forbidden("string", "string")},
+		{name: "deny-all-base", op: protopatch.Operation{Op: protopatch.OpClear, Path: ""}, opt: denyAll, wantErr: forbidden("", "")},
+		{name: "deny-leading-wildcard", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.string", Value: "x"}, opt: denyLeading, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-leading-wildcard-source", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "list.message.0.string", From: "message.string"}, opt: denyLeading, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-leading-wildcard-unrelated", op: protopatch.Operation{Op: protopatch.OpSet, Path: "list.message.0.string", Value: "x"}, opt: denyLeading},
+		{name: "deny-trailing-wildcard", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.int32", Value: int32(2)}, opt: denyTrailing, wantErr: forbidden("message.int32", "message.int32")},
+		{name: "deny-trailing-wildcard-source", op: protopatch.Operation{Op: protopatch.OpMove, Path: "string", From: "message.string"}, opt: denyTrailing, wantErr: forbidden("message.string", "message.string")},
+		{name: "deny-trailing-wildcard-unrelated", op: protopatch.Operation{Op: protopatch.OpSet, Path: "string", Value: "x"}, opt: denyTrailing},
+		{name: "allow-all", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "string", From: "message.string"}, opt: allowAll},
+		{name: "allow-all-base", op: protopatch.Operation{Op: protopatch.OpClear, Path: ""}, opt: allowAll, wantErr: forbidden("", "")},
+		{name: "allow-leading-wildcard", op: protopatch.Operation{Op: protopatch.OpSet, Path: "message.string", Value: "x"}, opt: allowLeading},
+		{name: "allow-leading-wildcard-target", op: protopatch.Operation{Op: protopatch.OpSet, Path: "string", Value: "x"}, opt: allowLeading, wantErr: forbidden("string", "string")},
+		{name: "allow-leading-wildcard-source", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "message.string", From: "list.message.0.string"}, opt: allowLeading, wantErr: forbidden("list.message.0.string", "list.message.0.string")},
+		{name: "allow-trailing-wildcard", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "message.int32", From: "message.message.int32"}, opt: allowTrailing},
+		{name: "allow-trailing-wildcard-target", op: protopatch.Operation{Op: protopatch.OpSet, Path: "int32", Value: int32(2)}, opt: allowTrailing, wantErr: forbidden("int32", "int32")},
+		{name: "allow-trailing-wildcard-source", op: protopatch.Operation{Op: protopatch.OpCopy, Path: "message.string", From: "string"}, opt: allowTrailing, wantErr: forbidden("string", "string")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(base)
+			err := protopatch.Apply(msg, protopatch.Patch{test.op}, test.opt)
+			if test.wantErr != nil {
+				require.Equal(t, protopatch.ErrInOperation{Index: 0, Op: test.op.Op, Cause: test.wantErr}, err)
+				patchtest.RequireEqual(t, base, msg, "message modified by forbidden operation")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPathPolicyFunc(t *testing.T) {
+	t.Parallel()
+
+	paths := []protopatch.Path(nil)
+	policy := protopatch.PathPolicyFunc(func(_ protoreflect.MessageDescriptor, path protopatch.Path) bool {
+		paths = append(paths, path)
+		return path != "list.string.1"
+	})
+	msg := &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b"}}}
+	err := protopatch.Set(msg, "list.string.*", "x", protopatch.WithPathPolicy(policy))
+	require.Equal(t, protopatch.NewErrInPath("list.string.1", protopatch.ErrPathForbidden{Path: "list.string.1"}), err)
+	require.Equal(t, []protopatch.Path{"list.string.0", "list.string.1"}, paths)
+	patchtest.RequireEqual(t, &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b"}}}, msg, "message modified by forbidden operation")
+}
+
+func TestPathPolicyCompiledPath(t *testing.T) {
+	t.Parallel()
+
+	msg := &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{}}
+	cp := protopatch.MustCompile(msg.ProtoReflect().Descriptor(), "message.string")
+	err := cp.Set(msg, "x", protopatch.WithPathPolicy(protopatch.DenyPaths("message.string")))
+	require.Equal(t, protopatch.NewErrInPath("message.string", protopatch.ErrPathForbidden{Path: "message.string"}), err)
+	patchtest.RequireEqual(t, &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{}}, msg, "message modified by forbidden operation")
+}
@@ -60,6 +60,9 @@ func isTypeMatchesProtoScalarKind(kind protoreflect.Kind, typ reflect.Type) bool
 	case reflect.Bool:
 		return kind == protoreflect.BoolKind
 	case reflect.Int32:
+		if typ == enumNumberType {
+			return kind == protoreflect.EnumKind
+		}
 		return kind == protoreflect.Int32Kind || kind == protoreflect.Sint32Kind || kind == protoreflect.Sfixed32Kind
 	case reflect.Int64:
 		return kind == protoreflect.Int64Kind || kind == protoreflect.Sint64Kind || kind == protoreflect.Sfixed64Kind
@@ -82,6 +85,7 @@ func isTypeMatchesProtoScalarKind(kind protoreflect.Kind, typ reflect.Type) bool
 var (
 	protoMessageType        = reflect.TypeOf((*proto.Message)(nil)).Elem()
 	protoreflectMessageType = reflect.TypeOf((*protoreflect.Message)(nil)).Elem()
+	enumNumberType          = reflect.TypeOf(protoreflect.EnumNumber(0))
 )
 
 // func isTypeImplementationOfProtoMessage(msgDesc protoreflect.MessageDescriptor, typ reflect.Type) bool {
@@ -393,7 +397,10 @@ func areProtoFieldMatch(x, y protoreflect.FieldDescriptor) bool {
 
 func fieldInMessage(fields protoreflect.FieldDescriptors, name string) (protoreflect.FieldDescriptor, error) {
 	if i, err := strconv.ParseInt(name, 10, 32); err == nil {
-		return fields.ByNumber(protoreflect.FieldNumber(i)), nil
+		if field := fields.ByNumber(protoreflect.FieldNumber(i)); field != nil {
+			return field, nil
+		}
+		return nil, ErrNotFound{Kind: "field", Value: name}
 	}
 	if field := fields.ByJSONName(name); field != nil {
 		return field, nil
@@ -0,0 +1,185 @@
+package protopatch
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WithRollback returns option that makes Apply restore the base message to its original state when any operation of the patch fails. Instead of cloning the base message up front, fields touched by every operation are recorded before the operation is performed and restored in reverse order on failure.
+func WithRollback() Option {
+	return optionFunc(func(s *setup) {
+		s.rollback = true
+	})
+}
+
+// undoLog records original values of message fields touched by patch operations.
+type undoLog []undoEntry
+
+type undoEntry struct {
+	msg   protoreflect.Message
+	field protoreflect.FieldDescriptor
+	has   bool
+	value protoreflect.Value
+}
+
+func (l *undoLog) recordOperation(base proto.Message, op Operation, setup *setup) {
+	if op.Op == OpTest { // test operation never mutates the base message
+		return
+	}
+	ops, err := expandOperation(base, op, setup)
+	if err != nil {
+		return // operation will fail before mutating anything
+	}
+	for _, op := range ops {
+		l.recordPath(base, op.Path, setup)
+	}
+	switch op.Op {
+	case OpMove, OpSwap:
+		l.recordPath(base, op.From, setup)
+	}
+}
+
+// recordPath records the field of the closest message that owns the value pointed by the given path. When the value is reached through a container that is not managed by this package (for example a container produced by a ContainerTransformer), the closest owning message field is recorded with a deep copy, as such containers may mutate values in place.
+func (l *undoLog) recordPath(base proto.Message, path string, setup *setup) {
+	pr := base.ProtoReflect()
+	if !pr.IsValid() {
+		return
+	}
+	if path == "" { // special case - an empty path; operation on the base message
+		l.recordMessage(pr, false)
+		return
+	}
+
+	trail, err := accessTrail(MessageContainer(base), Path(path), setup)
+	if err != nil {
+		return // operation will fail before mutating anything
+	}
+	segments := Path(path).Segments()
+	owner := -1
+	for i, c := range trail {
+		if !isOwnContainer(c) {
+			break
+		}
+		owner = i
+	}
+	if owner < 0 {
+		l.recordMessage(pr, true)
+		return
+	}
+	deep := owner != len(trail)-1
+	switch c := trail[owner].(type) {
+	case *messageContainer:
+		if !c.msg.IsValid() {
+			return
+		}
+		field, err := fieldInMessage(c.msg.Descriptor().Fields(), segments[owner].Value())
+		if err != nil || field == nil {
+			return
+		}
+		l.recordField(c.msg, field, deep)
+	case *listContainer:
+		if c.parent.IsValid() {
+			l.recordField(c.parent, c.parentField, deep)
+		}
+	case *mapContainer:
+		if c.parent.IsValid() {
+			l.recordField(c.parent, c.parentField, deep)
+		}
+	}
+}
+
+// accessTrail returns containers holding each of the path segments, that is the first returned container holds the first segment of the path, the second one holds the second segment, and so on.
+func accessTrail(c Container, path Path, setup *setup) ([]Container, error) {
+	c, err := transformContainer(c, setup)
+	if err != nil {
+		return nil, err
+	}
+	trail := make([]Container, 0, path.SegmentsCount())
+	for ps := range path.Iter {
+		trail = append(trail, c)
+		if ps.IsLast() {
+			break
+		}
+		c, err = accessOnce(c, ps, setup)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return trail, nil
+}
+
+func isOwnContainer(c Container) bool {
+	switch c.(type) {
+	case *messageContainer, *listContainer, *mapContainer:
+		return true
+	}
+	return false
+}
+
+func (l *undoLog) recordMessage(msg protoreflect.Message, deep bool) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		l.recordValue(msg, fields.Get(i), deep)
+	}
+}
+
+func (l *undoLog) recordField(msg protoreflect.Message, field protoreflect.FieldDescriptor, deep bool) {
+	if oneof := field.ContainingOneof(); oneof != nil { // setting one field of a oneof clears all others
+		fields := oneof.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			l.recordValue(msg, fields.Get(i), deep)
+		}
+		return
+	}
+	l.recordValue(msg, field, deep)
+}
+
+func (l *undoLog) recordValue(msg protoreflect.Message, field protoreflect.FieldDescriptor, deep bool) {
+	e := undoEntry{msg: msg, field: field, has: msg.Has(field)}
+	if e.has {
+		e.value = snapshotValue(msg, field, deep)
+	}
+	*l = append(*l, e)
+}
+
+// snapshotValue returns a copy of the field value that is not affected by later mutations of the field. Lists and maps are always copied, as their values are views on the message field. Messages are copied only in deep mode, otherwise the very same message is retained, so that undo entries recorded for its fields remain valid.
+func snapshotValue(msg protoreflect.Message, field protoreflect.FieldDescriptor, deep bool) protoreflect.Value {
+	v := msg.Get(field)
+	switch {
+	case field.IsList():
+		cp := msg.NewField(field)
+		li, cpLi := v.List(), cp.List()
+		for i := 0; i < li.Len(); i++ {
+			cpLi.Append(snapshotElement(field.Kind(), li.Get(i), deep))
+		}
+		return cp
+	case field.IsMap():
+		cp := msg.NewField(field)
+		cpMa := cp.Map()
+		v.Map().Range(func(k protoreflect.MapKey, el protoreflect.Value) bool {
+			cpMa.Set(k, snapshotElement(field.MapValue().Kind(), el, deep))
+			return true
+		})
+		return cp
+	}
+	return snapshotElement(field.Kind(), v, deep)
+}
+
+func snapshotElement(kind protoreflect.Kind, v protoreflect.Value, deep bool) protoreflect.Value {
+	if deep && (kind == protoreflect.MessageKind || kind == protoreflect.GroupKind) {
+		return protoreflect.ValueOfMessage(proto.Clone(v.Message().Interface()).ProtoReflect())
+	}
+	return v
+}
+
+// rollback restores all recorded values in reverse order.
+func (l undoLog) rollback() {
+	for i := len(l) - 1; i >= 0; i-- {
+		e := l[i]
+		if e.has {
+			e.msg.Set(e.field, e.value)
+		} else {
+			e.msg.Clear(e.field)
+		}
+	}
+}
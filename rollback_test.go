@@ -0,0 +1,168 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestApplyWithRollback(t *testing.T) {
+	t.Parallel()
+
+	failure := protopatch.Operation{Op: protopatch.OpSet, Path: "unknown", Value: "zzz"}
+
+	tests := []struct {
+		name  string
+		base  proto.Message
+		patch protopatch.Patch
+		opts  []protopatch.Option
+	}{
+		{
+			name: "scalars",
+			base: &protopatchv1.TestMessage{String_: "aaa", Int32: 1, Message: &protopatchv1.TestMessage{Bool: true}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "bbb"},
+				{Op: protopatch.OpClear, Path: "int32"},
+				{Op: protopatch.OpSet, Path: "message.bool", Value: false},
+				{Op: protopatch.OpSet, Path: "message.double", Value: 1.5},
+				failure,
+			},
+		},
+		{
+			name: "message-replaced-after-nested-change",
+			base: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{Int64: 1}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "message.message.int64", Value: int64(2)},
+				{Op: protopatch.OpSet, Path: "message.string", Value: "bbb"},
+				{Op: protopatch.OpSet, Path: "message", Value: &protopatchv1.TestMessage{String_: "ccc"}},
+				{Op: protopatch.OpSet, Path: "message.int32", Value: int32(3)},
+				failure,
+			},
+		},
+		{
+			name: "unset-message",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "message", Value: &protopatchv1.TestMessage{String_: "bbb"}},
+				{Op: protopatch.OpSet, Path: "message.int32", Value: int32(3)},
+				failure,
+			},
+		},
+		{
+			name: "scalar-list",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpAppend, Path: "list.string", Value: "d"},
+				{Op: protopatch.OpInsert, Path: "list.string.0", Value: "e"},
+				{Op: protopatch.OpSet, Path: "list.string.1", Value: "f"},
+				{Op: protopatch.OpSwap, Path: "list.string.0", From: "list.string.2"},
+				{Op: protopatch.OpClear, Path: "list.string.-1"},
+				failure,
+			},
+		},
+		{
+			name: "message-list",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b"}}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message.0.string", Value: "c"},
+				{Op: protopatch.OpAppend, Path: "list.message", Value: &protopatchv1.TestMessage{String_: "d"}},
+				{Op: protopatch.OpSet, Path: "list.message.1.int32", Value: int32(1)},
+				{Op: protopatch.OpSet, Path: "list.message", Value: []*protopatchv1.TestMessage{{String_: "e"}}},
+				{Op: protopatch.OpSet, Path: "list.message.0.string", Value: "f"},
+				failure,
+			},
+		},
+		{
+			name: "map",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{
+				StringToString:  map[string]string{"a": "a", "b": "b"},
+				StringToMessage: map[string]*protopatchv1.TestMessage{"a": {String_: "a"}},
+			}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "map.stringToString.a", Value: "c"},
+				{Op: protopatch.OpSet, Path: "map.stringToString.new", Value: "d"},
+				{Op: protopatch.OpClear, Path: "map.stringToString.b"},
+				{Op: protopatch.OpSet, Path: "map.stringToMessage.a.string", Value: "e"},
+				{Op: protopatch.OpCopy, Path: "map.stringToMessage.b", From: "map.stringToMessage.a"},
+				{Op: protopatch.OpMove, Path: "map.stringToString.new", From: "map.stringToString.a"},
+				failure,
+			},
+		},
+		{
+			name: "oneof",
+			base: &protopatchv1.TestMessage{Oneof: &protopatchv1.TestOneof{Types: &protopatchv1.TestOneof_String_{String_: "aaa"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "oneof.int32", Value: int32(1)},
+				{Op: protopatch.OpSet, Path: "oneof.message", Value: &protopatchv1.TestMessage{String_: "bbb"}},
+				failure,
+			},
+		},
+		{
+			name: "base",
+			base: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "bbb"}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "message.string", Value: "ccc"},
+				{Op: protopatch.OpSet, Path: "", Value: &protopatchv1.TestMessage{Int32: 1}},
+				{Op: protopatch.OpCopy, Path: "message", From: ""},
+				failure,
+			},
+		},
+		{
+			name: "clear-base",
+			base: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "bbb"}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: ""},
+				failure,
+			},
+		},
+		{
+			name: "failing-move",
+			base: &protopatchv1.TestMessage{String_: "aaa", Message: &protopatchv1.TestMessage{String_: "bbb"}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "string", Value: "ccc"},
+				{Op: protopatch.OpMove, Path: "message.string", From: "int32"},
+			},
+		},
+		{
+			name: "transformed-container",
+			base: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"key0": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+						"key1": structpb.NewStringValue("aaa"),
+					}}),
+				}}),
+			}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "wellKnown.value.key0.key1", Value: structpb.NewStringValue("bbb")},
+				{Op: protopatch.OpSet, Path: "wellKnown.value.key0.key2", Value: structpb.NewStringValue("ccc")},
+				{Op: protopatch.OpClear, Path: "wellKnown.value.key0.key1"},
+				failure,
+			},
+			opts: []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			base := proto.Clone(test.base)
+			err := protopatch.Apply(base, test.patch, append(test.opts, protopatch.WithRollback())...)
+			require.Error(t, err)
+			patchtest.RequireEqual(t, test.base, base, "message after rollback mismatch")
+
+			withoutFailure := test.patch[:len(test.patch)-1]
+			want := proto.Clone(test.base)
+			require.NoError(t, protopatch.Apply(want, withoutFailure, test.opts...))
+			got := proto.Clone(test.base)
+			require.NoError(t, protopatch.Apply(got, withoutFailure, append(test.opts, protopatch.WithRollback())...))
+			patchtest.RequireEqual(t, want, got, "message after successful apply mismatch")
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package protopatch
 
 import (
+	"errors"
 	"reflect"
 
 	"google.golang.org/protobuf/proto"
@@ -138,9 +139,17 @@ func setWithSetup(base proto.Message, path string, to any, setup *setup) error {
 	if to == nil {
 		return clearWithSetup(base, path, setup)
 	}
+	if err := checkPath(base, path, setup); err != nil {
+		return err
+	}
 	if path == "" { // special case - an empty path; set of the base message
 		return setSelf(base, to, setup)
 	}
+	if Path(path).HasWildcard() {
+		return forEachExpandedPath(base, Path(path), false, setup, func(p Path) error {
+			return setWithSetup(base, string(p), valueForEach(to), setup)
+		})
+	}
 
 	c := MessageContainer(base)
 	p := Path(path)
@@ -150,7 +159,7 @@ func setWithSetup(base proto.Message, path string, to any, setup *setup) error {
 		if err != nil {
 			return err
 		}
-		ref, err := a.GetNew(last.Value())
+		ref, err := getNewRef(a, last.Value())
 		if err != nil {
 			return NewErrInPath(string(last.PrecedingPath()), err)
 		}
@@ -170,7 +179,8 @@ func setWithSetup(base proto.Message, path string, to any, setup *setup) error {
 	if err != nil {
 		return err
 	}
-	ref, err := a.GetNew(path)
+	key := p.Last().Value()
+	ref, err := getNewRef(a, key)
 	if err != nil {
 		return err
 	}
@@ -178,7 +188,7 @@ func setWithSetup(base proto.Message, path string, to any, setup *setup) error {
 	if err != nil {
 		return NewErrInPath(path, err)
 	}
-	err = c.Set(path, conv)
+	err = a.Set(key, conv)
 	if err != nil {
 		return err
 	}
@@ -190,6 +200,7 @@ func setSelf(base proto.Message, to any, setup *setup) error {
 		return clearSelf(base, setup)
 	}
 	c := MessageContainer(base).(*messageContainer)
+	guardContainer(c, setup)
 	conv, err := convert(c.Self(), to, setup)
 	if err != nil {
 		return err
@@ -223,18 +234,20 @@ func setToItself(base proto.Message, path string, setup *setup) error {
 	if p == "" { // path points to the base message
 		return nil
 	}
-	v, err := a.Get(path)
+	key := p.Last().Value()
+	v, err := a.Get(key)
 	if err != nil {
 		return err
 	}
-	err = a.Set(path, v)
+	err = a.Set(key, v)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func getCopyAndSetter(base proto.Message, path string, setup *setup) (any, func(any) error, error) {
+// getCopyAndSetter returns copy of the value pointed by the path and function setting it. When allowMissingKey is true, a map key that does not exist yet is reported as nil value, so that it can be set (restoring nil clears the key).
+func getCopyAndSetter(base proto.Message, path string, allowMissingKey bool, setup *setup) (any, func(any) error, error) {
 	if path == "" { // special case - an empty path; set of the base message
 		original := proto.Clone(base)
 		setFn := func(to any) error {
@@ -252,12 +265,15 @@ func getCopyAndSetter(base proto.Message, path string, setup *setup) (any, func(
 			return nil, nil, err
 		}
 		original, err := a.GetCopy(last.Value())
+		if allowMissingKey && isMissingKey(err) {
+			original, err = nil, nil
+		}
 		if err != nil {
 			return nil, nil, NewErrInPath(string(last.PrecedingPath()), err)
 		}
 		setFn := func(to any) error {
 			if to != nil {
-				ref, err := a.GetNew(last.Value())
+				ref, err := getNewRef(a, last.Value())
 				if err != nil {
 					return NewErrInPath(string(last.PrecedingPath()), err)
 				}
@@ -280,13 +296,17 @@ func getCopyAndSetter(base proto.Message, path string, setup *setup) (any, func(
 	if err != nil {
 		return nil, nil, err
 	}
-	original, err := a.GetCopy(path)
+	key := p.Last().Value()
+	original, err := a.GetCopy(key)
+	if allowMissingKey && isMissingKey(err) {
+		original, err = nil, nil
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	setFn := func(to any) error {
 		if to != nil {
-			ref, err := a.GetNew(path)
+			ref, err := getNewRef(a, key)
 			if err != nil {
 				return err
 			}
@@ -295,7 +315,7 @@ func getCopyAndSetter(base proto.Message, path string, setup *setup) (any, func(
 				return NewErrInPath(path, err)
 			}
 		}
-		err = c.Set(path, to)
+		err = a.Set(key, to)
 		if err != nil {
 			return err
 		}
@@ -304,6 +324,11 @@ func getCopyAndSetter(base proto.Message, path string, setup *setup) (any, func(
 	return original, setFn, nil
 }
 
+func isMissingKey(err error) bool {
+	nf := ErrNotFound{}
+	return errors.As(err, &nf) && nf.Kind == "key"
+}
+
 func (c *messageContainer) setSelf(to any) error {
 	if to == nil {
 		return c.clearSelf()
@@ -318,6 +343,9 @@ func (c *messageContainer) setSelf(to any) error {
 	if c.msg.Descriptor() != pr.Descriptor() {
 		return newSetFailure(ErrMismatchingType)
 	}
+	if err := c.guard.replace(c.msg, pr); err != nil {
+		return err
+	}
 	c.copyFields(pr)
 	return nil
 }
@@ -345,6 +373,23 @@ func (c *messageContainer) Set(key string, to any) error {
 	if err != nil {
 		return err
 	}
+	return c.setField(key, field, to)
+}
+
+// setField works like Set, but with already resolved field. The key is used only to describe errors.
+func (c *messageContainer) setField(key string, field protoreflect.FieldDescriptor, to any) error {
+	if to == nil {
+		return c.clearField(field)
+	}
+	if c.ro {
+		return ErrMutationOfReadOnlyValue
+	}
+	if err := c.guard.field(field); err != nil {
+		return err
+	}
+	if err := c.guard.oneof(c.msg, field); err != nil {
+		return err
+	}
 	if field.IsList() {
 		return NewErrInPath(key, c.setList(field, to))
 	}
@@ -369,6 +414,9 @@ func (c *messageContainer) setMessage(field protoreflect.FieldDescriptor, to any
 	if field.Message() != pr.Descriptor() {
 		return newSetFailure(ErrMismatchingType)
 	}
+	if err := c.guard.replace(c.msg.Get(field).Message(), pr); err != nil {
+		return err
+	}
 	c.msg.Set(field, protoreflect.ValueOfMessage(pr))
 	return nil
 }
@@ -563,7 +611,10 @@ func (c *listContainer) Set(key string, to any) error {
 	if c.ro {
 		return ErrMutationOfReadOnlyValue
 	}
-	idx, err := indexInList(c.li, key)
+	if err := c.guard.mutable(); err != nil {
+		return err
+	}
+	idx, err := c.index(key)
 	if err != nil {
 		return err
 	}
@@ -658,6 +709,9 @@ func (c *mapContainer) Set(key string, to any) error {
 	if c.ro {
 		return ErrMutationOfReadOnlyValue
 	}
+	if err := c.guard.mutable(); err != nil {
+		return err
+	}
 	mk, err := keyInMap(c.ma, c.parentField.MapKey(), key)
 	if err != nil {
 		newMk, parseErr := parseMapKey(c.parentField.MapKey(), key) // check it it is a new map key insertion
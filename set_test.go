@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/daishe/protopatch"
 	"github.com/daishe/protopatch/internal/patchtest"
@@ -23,6 +25,17 @@ func TestSet(t *testing.T) {
 		return nil, protopatch.ErrNoConversionDefined
 	}))
 
+	// enumNumberOpt is a conversion option with a converter that converts "other" string only to protoreflect.EnumNumber targets - useful for testing that converters not implementing protopatch.EnumConverter still receive enum numbers
+	enumNumberOpt := protopatch.WithConversion(protopatch.ConverterFunc(func(to, from any) (any, error) {
+		if _, ok := to.(protoreflect.EnumNumber); ok && from == "other" {
+			return protoreflect.EnumNumber(1), nil
+		}
+		return nil, protopatch.ErrNoConversionDefined
+	}))
+
+	// enumNameOpt is a conversion option with a converter resolving enum values by their names (see enumNameConverter)
+	enumNameOpt := protopatch.WithConversion(enumNameConverter{})
+
 	mustAccessSelf := func(base proto.Message, path string) any {
 		c, err := protopatch.Access(protopatch.MessageContainer(base), protopatch.Path(path))
 		require.NoError(t, err)
@@ -95,6 +108,96 @@ func TestSet(t *testing.T) {
 			value:   123,
 			wantErr: protopatch.NewErrInPath("string", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
 		},
+		{
+			name:  "scalar/set-enum",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: protoreflect.EnumNumber(1),
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+		},
+		{
+			name:  "scalar/set-enum-generated",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: protopatchv1.Enum_ENUM_VALUE_OTHER,
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+		},
+		{
+			name:  "scalar/set-enum-with-enum-number-converter",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: "other",
+			opts:  []protopatch.Option{enumNumberOpt},
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+		},
+		{
+			name:  "list/item/set-enum-with-enum-number-converter",
+			base:  &protopatchv1.TestMessage{List: &protopatchv1.TestList{Enum: []protopatchv1.Enum{0}}},
+			path:  "list.enum.0",
+			value: "other",
+			opts:  []protopatch.Option{enumNumberOpt},
+			want:  &protopatchv1.TestMessage{List: &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER}}},
+		},
+		{
+			name:  "map/item/set-enum-with-enum-number-converter",
+			base:  &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{}},
+			path:  "map.stringToEnum.a",
+			value: "other",
+			opts:  []protopatch.Option{enumNumberOpt},
+			want:  &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToEnum: map[string]protopatchv1.Enum{"a": protopatchv1.Enum_ENUM_VALUE_OTHER}}},
+		},
+		{
+			name:  "scalar/set-enum-with-enum-converter",
+			base:  &protopatchv1.TestMessage{},
+			path:  "enum",
+			value: "ENUM_VALUE_OTHER",
+			opts:  []protopatch.Option{enumNameOpt},
+			want:  &protopatchv1.TestMessage{Enum: protopatchv1.Enum_ENUM_VALUE_OTHER},
+		},
+		{
+			name:  "list/item/set-enum-with-enum-converter",
+			base:  &protopatchv1.TestMessage{List: &protopatchv1.TestList{Enum: []protopatchv1.Enum{0}}},
+			path:  "list.enum.0",
+			value: "ENUM_VALUE_OTHER",
+			opts:  []protopatch.Option{enumNameOpt},
+			want:  &protopatchv1.TestMessage{List: &protopatchv1.TestList{Enum: []protopatchv1.Enum{protopatchv1.Enum_ENUM_VALUE_OTHER}}},
+		},
+		{
+			name:  "map/item/set-enum-with-enum-converter",
+			base:  &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{}},
+			path:  "map.stringToEnum.a",
+			value: "ENUM_VALUE_OTHER",
+			opts:  []protopatch.Option{enumNameOpt},
+			want:  &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToEnum: map[string]protopatchv1.Enum{"a": protopatchv1.Enum_ENUM_VALUE_OTHER}}},
+		},
+		{
+			name:  "scalar/set-closed-enum",
+			base:  &descriptorpb.FieldDescriptorProto{},
+			path:  "type",
+			value: protoreflect.EnumNumber(descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			want:  &descriptorpb.FieldDescriptorProto{Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+		},
+		{
+			name:    "scalar/set-closed-enum-unknown-number",
+			base:    &descriptorpb.FieldDescriptorProto{},
+			path:    "type",
+			value:   protoreflect.EnumNumber(99),
+			wantErr: protopatch.NewErrInPath("type", protopatch.ErrUnknownEnumNumber{Enum: "google.protobuf.FieldDescriptorProto.Type", Number: 99}),
+		},
+		{
+			name:    "scalar/set-closed-enum-unknown-generated",
+			base:    &descriptorpb.FieldDescriptorProto{},
+			path:    "type",
+			value:   descriptorpb.FieldDescriptorProto_Type(99),
+			wantErr: protopatch.NewErrInPath("type", protopatch.ErrUnknownEnumNumber{Enum: "google.protobuf.FieldDescriptorProto.Type", Number: 99}),
+		},
+		{
+			name:    "scalar/set-enum-wrong-type",
+			base:    &protopatchv1.TestMessage{},
+			path:    "enum",
+			value:   int32(1),
+			wantErr: protopatch.NewErrInPath("enum", protopatch.ErrOperationFailed{Op: "set", Cause: protopatch.ErrMismatchingType}),
+		},
 		{
 			name:  "oneof/unset-scalar/set",
 			base:  &protopatchv1.TestOneof{},
@@ -271,6 +374,13 @@ func TestSet(t *testing.T) {
 			value:   &protopatchv1.TestMessage{String_: "bbb"},
 			wantErr: protopatch.ErrNotFound{Kind: "field", Value: "unknown"},
 		},
+		{
+			name:    "message/set-unknown-field-number",
+			base:    &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{String_: "aaa"}},
+			path:    "message.99",
+			value:   "bbb",
+			wantErr: protopatch.NewErrInPath("message", protopatch.ErrNotFound{Kind: "field", Value: "99"}),
+		},
 		{
 			name:  "oneof/unset-message/set",
 			base:  &protopatchv1.TestOneof{},
@@ -489,6 +599,22 @@ func TestSet(t *testing.T) {
 	}
 }
 
+// enumNameConverter is a converter resolving enum values by their names.
+type enumNameConverter struct{}
+
+func (enumNameConverter) Convert(to, from any) (any, error) {
+	return nil, protopatch.ErrNoConversionDefined
+}
+
+func (enumNameConverter) ConvertEnum(enum protoreflect.EnumDescriptor, from any) (any, error) {
+	if name, ok := from.(string); ok {
+		if v := enum.Values().ByName(protoreflect.Name(name)); v != nil {
+			return v.Number(), nil
+		}
+	}
+	return nil, protopatch.ErrNoConversionDefined
+}
+
 func TestContainerSetFailures(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,11 @@ package protopatch
 
 import (
 	"errors"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daishe/protopatch/internal/protoops"
 )
 
 type Option interface {
@@ -20,6 +25,14 @@ type Converter interface {
 	Convert(to, from any) (any, error)
 }
 
+// EnumConverter is an optional interface of converters that need to know the enum of an enum conversion target, for example to resolve enum values by their names. For enum fields, list items and map values of containers managed by this package ConvertEnum is called instead of Convert (which receives only a protoreflect.EnumNumber).
+type EnumConverter interface {
+	Converter
+
+	// ConvertEnum performs conversion to a value of the given enum from the provided value. Returned value must be a protoreflect.EnumNumber or function should return an error. If conversion is not defined for provided value ConvertEnum function should return an unwrapped ErrNoConversionDefined error.
+	ConvertEnum(enum protoreflect.EnumDescriptor, from any) (any, error)
+}
+
 // ConverterFunc allows to implement Converter interface with a function.
 type ConverterFunc func(to, from any) (any, error)
 
@@ -31,6 +44,13 @@ func WithConversion(converters ...Converter) Option {
 	})
 }
 
+// WithAdditionalConversion adds the provided converters after the already configured ones (see WithConversion), so that they are used only for conversions not defined by the converters configured earlier.
+func WithAdditionalConversion(converters ...Converter) Option {
+	return optionFunc(func(s *setup) {
+		s.convert = append(s.convert[:len(s.convert):len(s.convert)], converters...)
+	})
+}
+
 var ErrNoContainerTransformationDefined = errors.New("no transformation defined for the provided container")
 
 // ContainerTransformer represents an entity that can modify freshly accessed container.
@@ -55,6 +75,12 @@ func WithContainerTransformation(transformers ...ContainerTransformer) Option {
 type setup struct {
 	convert   []Converter
 	transform []ContainerTransformer
+	rollback  bool
+	listMoves bool
+	policy    []PathPolicy
+	resolve   []OperationResolver
+
+	fieldBehavior bool
 }
 
 func newSetup(opts ...Option) *setup {
@@ -67,7 +93,7 @@ func newSetup(opts ...Option) *setup {
 
 func (s *setup) Convert(to, from any) (any, error) {
 	for _, c := range s.convert {
-		v, err := c.Convert(to, from)
+		v, err := convertWith(c, to, from)
 		if err == ErrNoConversionDefined {
 			continue
 		}
@@ -79,6 +105,29 @@ func (s *setup) Convert(to, from any) (any, error) {
 	return IdentityConverter(to, from)
 }
 
+// convertWith performs conversion with the given converter. Enum conversion targets carrying their enum descriptors (see getNewRef) are passed to ConvertEnum of converters implementing EnumConverter interface and as protoreflect.EnumNumber to all other converters.
+func convertWith(c Converter, to, from any) (any, error) {
+	e, ok := protoops.AsEnumRef(to)
+	if !ok {
+		return c.Convert(to, from)
+	}
+	if ec, ok := c.(EnumConverter); ok {
+		return ec.ConvertEnum(e.Descriptor(), from)
+	}
+	return c.Convert(e.Number(), from)
+}
+
+func (s *setup) ResolveOperation(base proto.Message, op Operation) (Operation, error) {
+	for _, r := range s.resolve {
+		resolved, err := r.ResolveOperation(base, op)
+		if err != nil {
+			return op, err
+		}
+		op = resolved
+	}
+	return op, nil
+}
+
 func convert(to, from any, setup *setup) (any, error) {
 	conv, err := setup.Convert(to, from)
 	if err == ErrNoConversionDefined {
@@ -102,6 +151,7 @@ func (s *setup) TransformContainer(c Container) (Container, error) {
 }
 
 func transformContainer(c Container, setup *setup) (Container, error) {
+	guardContainer(c, setup)
 	n, err := setup.TransformContainer(c)
 	if err == ErrNoContainerTransformationDefined {
 		n, err = c, nil
@@ -7,15 +7,27 @@ func Swap(base proto.Message, targetPath, replacementPath string, opts ...Option
 }
 
 func swapWithSetup(base proto.Message, firstPath, secondPath string, setup *setup) error {
+	if err := checkNoWildcard(firstPath, OpSwap); err != nil {
+		return err
+	}
+	if err := checkNoWildcard(secondPath, OpSwap); err != nil {
+		return err
+	}
+	if err := checkPath(base, firstPath, setup); err != nil {
+		return err
+	}
+	if err := checkPath(base, secondPath, setup); err != nil {
+		return err
+	}
 	if firstPath == secondPath { // set value pointed by path to itself
 		return setToItself(base, firstPath, setup)
 	}
 
-	firstValue, firstSet, err := getCopyAndSetter(base, firstPath, setup)
+	firstValue, firstSet, err := getCopyAndSetter(base, firstPath, false, setup)
 	if err != nil {
 		return err
 	}
-	secondValue, secondSet, err := getCopyAndSetter(base, secondPath, setup)
+	secondValue, secondSet, err := getCopyAndSetter(base, secondPath, false, setup)
 	if err != nil {
 		return err
 	}
@@ -60,6 +60,13 @@ func TestSwap(t *testing.T) {
 			secondPath: "stringToString.key1",
 			want:       &protopatchv1.TestMap{StringToString: map[string]string{"key0": "bbb", "key1": "aaa"}},
 		},
+		{
+			name:       "scalar-map/item/with/scalar-map/missing-item",
+			base:       &protopatchv1.TestMap{StringToString: map[string]string{"key0": "aaa"}},
+			firstPath:  "stringToString.key0",
+			secondPath: "stringToString.key1",
+			wantErr:    protopatch.NewErrInPath("stringToString", protopatch.ErrNotFound{Kind: "key", Value: "key1"}),
+		},
 
 		{
 			name:       "base/with/message",
@@ -0,0 +1,141 @@
+package protopatch
+
+import (
+	"bytes"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Test compares the value pointed by the given path with the expected value. The expected value is converted to the type of the current value with the configured converters. Messages are compared with proto.Equal and lists and maps are compared element-wise. A nil expected value (or a value converted to nil) matches only an element that is not populated, that is an unset message field (for fields without presence, a field holding zero value or an empty list or map) or a nonexistent map key. On mismatch it returns ErrTestFailed error wrapped in ErrInPath error.
+func Test(base proto.Message, path string, expected any, opts ...Option) error {
+	return testWithSetup(base, path, expected, newSetup(opts...))
+}
+
+func testWithSetup(base proto.Message, path string, expected any, setup *setup) error {
+	if err := checkNoWildcard(path, OpTest); err != nil {
+		return err
+	}
+	if err := checkPath(base, path, setup); err != nil {
+		return err
+	}
+	if path == "" { // special case - an empty path; test the base message
+		return compareForTest(path, base, base.ProtoReflect().IsValid(), base.ProtoReflect().New().Interface(), expected, setup)
+	}
+
+	c, last, err := accessParent(base, Path(path), setup)
+	if err != nil {
+		return err
+	}
+
+	actual, err := c.Get(last.Value())
+	if err != nil && !isMissingKey(err) {
+		if last.IsFirst() {
+			return err
+		}
+		return NewErrInPath(string(last.PrecedingPath()), err)
+	}
+	present := err == nil && isPopulated(c, last.Value())
+	ref, err := getNewRef(c, last.Value())
+	if err != nil {
+		if last.IsFirst() {
+			return err
+		}
+		return NewErrInPath(string(last.PrecedingPath()), err)
+	}
+	return compareForTest(path, actual, present, ref, expected, setup)
+}
+
+// compareForTest compares actual value with the expected one. The expected value is converted using ref, a new element of the same type as the actual value, as the conversion target.
+func compareForTest(path string, actual any, present bool, ref, expected any, setup *setup) error {
+	if expected != nil && actual != nil {
+		conv, err := convert(ref, expected, setup)
+		if err != nil {
+			return NewErrInPath(path, err)
+		}
+		expected = conv
+	}
+	if expected == nil {
+		if present {
+			return NewErrInPath(path, ErrTestFailed{Expected: nil, Actual: actual})
+		}
+		return nil
+	}
+	if actual == nil || !equalValues(actual, expected) {
+		return NewErrInPath(path, ErrTestFailed{Expected: expected, Actual: actual})
+	}
+	return nil
+}
+
+// isPopulated reports whether the existing element under the given key is populated. Only message fields may be not populated.
+func isPopulated(c Container, key string) bool {
+	mc, ok := unwrapContainer(c).(*messageContainer)
+	if !ok {
+		return true
+	}
+	if !mc.msg.IsValid() {
+		return false
+	}
+	field, err := fieldInMessage(mc.msg.Descriptor().Fields(), key)
+	return err == nil && field != nil && mc.msg.Has(field)
+}
+
+// equalValues reports whether the provided values are equal, comparing messages with proto.Equal and lists and maps element-wise.
+func equalValues(x, y any) bool {
+	if li, ok := x.(List); ok {
+		x = li.AsGoSlice()
+	}
+	if li, ok := y.(List); ok {
+		y = li.AsGoSlice()
+	}
+	if ma, ok := x.(Map); ok {
+		x = ma.AsGoMap()
+	}
+	if ma, ok := y.(Map); ok {
+		y = ma.AsGoMap()
+	}
+	if xm, ok := x.(proto.Message); ok {
+		ym, ok := y.(proto.Message)
+		return ok && proto.Equal(xm, ym)
+	}
+	if xv, ok := x.(protoreflect.Value); ok {
+		x = xv.Interface()
+	}
+	if yv, ok := y.(protoreflect.Value); ok {
+		y = yv.Interface()
+	}
+	if xb, ok := x.([]byte); ok {
+		yb, ok := y.([]byte)
+		return ok && bytes.Equal(xb, yb)
+	}
+
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	if !xv.IsValid() || !yv.IsValid() {
+		return xv.IsValid() == yv.IsValid()
+	}
+	switch {
+	case xv.Kind() == reflect.Slice && yv.Kind() == reflect.Slice:
+		if xv.Len() != yv.Len() {
+			return false
+		}
+		for i := 0; i < xv.Len(); i++ {
+			if !equalValues(xv.Index(i).Interface(), yv.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	case xv.Kind() == reflect.Map && yv.Kind() == reflect.Map:
+		if xv.Len() != yv.Len() || xv.Type().Key() != yv.Type().Key() {
+			return false
+		}
+		for it := xv.MapRange(); it.Next(); {
+			el := yv.MapIndex(it.Key())
+			if !el.IsValid() || !equalValues(it.Value().Interface(), el.Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+	return xv.Type() == yv.Type() && xv.Equal(yv)
+}
@@ -0,0 +1,210 @@
+package protopatch_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestTest(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestMessage{
+		String_: "aaa",
+		Bytes:   []byte("bbb"),
+		Message: &protopatchv1.TestMessage{Int64: 1},
+		List: &protopatchv1.TestList{
+			String_: []string{"a", "b"},
+			Message: []*protopatchv1.TestMessage{{String_: "a"}},
+		},
+		Map: &protopatchv1.TestMap{
+			StringToString:  map[string]string{"a": "a"},
+			StringToMessage: map[string]*protopatchv1.TestMessage{"a": {String_: "a"}},
+		},
+		WellKnown: &protopatchv1.TestWellKnown{Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"key": structpb.NewStringValue("aaa"),
+		}})},
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected any
+		opts     []protopatch.Option
+		wantErr  error
+		errPath  string // defaults to path
+	}{
+		{
+			name:     "base",
+			path:     "",
+			expected: proto.Clone(base),
+		},
+		{
+			name:     "base/mismatch",
+			path:     "",
+			expected: &protopatchv1.TestMessage{},
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "scalar",
+			path:     "string",
+			expected: "aaa",
+		},
+		{
+			name:     "scalar/mismatch",
+			path:     "string",
+			expected: "bbb",
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "scalar/wrong-type",
+			path:     "string",
+			expected: 1,
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "scalar/zero",
+			path:     "int32",
+			expected: int32(0),
+		},
+		{
+			name:     "scalar/unpopulated",
+			path:     "int32",
+			expected: nil,
+		},
+		{
+			name:     "scalar/populated",
+			path:     "string",
+			expected: nil,
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "bytes",
+			path:     "bytes",
+			expected: []byte("bbb"),
+		},
+		{
+			name:     "message",
+			path:     "message",
+			expected: &protopatchv1.TestMessage{Int64: 1},
+		},
+		{
+			name:     "message/mismatch",
+			path:     "message",
+			expected: &protopatchv1.TestMessage{Int64: 2},
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "message/unset",
+			path:     "oneof",
+			expected: nil,
+		},
+		{
+			name:     "nested-scalar",
+			path:     "message.int64",
+			expected: int64(1),
+		},
+		{
+			name:     "list",
+			path:     "list.string",
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "list/mismatch",
+			path:     "list.string",
+			expected: []string{"a"},
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "list/messages",
+			path:     "list.message",
+			expected: []*protopatchv1.TestMessage{{String_: "a"}},
+		},
+		{
+			name:     "list/item",
+			path:     "list.string.-1",
+			expected: "b",
+		},
+		{
+			name:     "map",
+			path:     "map.stringToMessage",
+			expected: map[string]*protopatchv1.TestMessage{"a": {String_: "a"}},
+		},
+		{
+			name:     "map/mismatch",
+			path:     "map.stringToString",
+			expected: map[string]string{"a": "b"},
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "map/item",
+			path:     "map.stringToString.a",
+			expected: "a",
+		},
+		{
+			name:     "map/missing-item",
+			path:     "map.stringToString.b",
+			expected: nil,
+		},
+		{
+			name:     "map/missing-item-mismatch",
+			path:     "map.stringToString.b",
+			expected: "b",
+			wantErr:  protopatch.ErrTestFailed{},
+		},
+		{
+			name:     "conversion",
+			path:     "message.int64",
+			expected: structpb.NewStringValue("1"),
+			opts:     []protopatch.Option{protopatch.WithConversion(patchstructpb.FromValueConverter())},
+		},
+		{
+			name:     "conversion/message",
+			path:     "message",
+			expected: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"int64": structpb.NewNumberValue(1)}}),
+			opts:     []protopatch.Option{protopatch.WithConversion(patchstructpb.FromValueConverter())},
+		},
+		{
+			name:     "transformed-container",
+			path:     "wellKnown.value.key",
+			expected: structpb.NewStringValue("aaa"),
+			opts:     []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())},
+		},
+		{
+			name:     "unknown-field",
+			path:     "message.unknown",
+			expected: "aaa",
+			wantErr:  protopatch.ErrNotFound{},
+			errPath:  "message",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			msg := proto.Clone(base)
+			err := protopatch.Test(msg, test.path, test.expected, test.opts...)
+			require.True(t, proto.Equal(base, msg), "message modified by test operation")
+
+			if test.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			pe := protopatch.ErrInPath{}
+			require.True(t, errors.As(err, &pe), "error is not an ErrInPath error: %v", err)
+			if test.errPath == "" {
+				test.errPath = test.path
+			}
+			require.Equal(t, test.errPath, pe.Path)
+			require.IsType(t, test.wantErr, pe.Cause)
+		})
+	}
+}
@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: protopatch/v1/patch.proto
+
+package protopatchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Op identifies the kind of a patch operation.
+type Operation_Op int32
+
+const (
+	Operation_OP_UNSPECIFIED Operation_Op = 0
+	Operation_OP_SET         Operation_Op = 1
+	Operation_OP_APPEND      Operation_Op = 2
+	Operation_OP_INSERT      Operation_Op = 3
+	Operation_OP_CLEAR       Operation_Op = 4
+	Operation_OP_COPY        Operation_Op = 5
+	Operation_OP_MOVE        Operation_Op = 6
+	Operation_OP_SWAP        Operation_Op = 7
+	Operation_OP_TEST        Operation_Op = 8
+)
+
+// Enum value maps for Operation_Op.
+var (
+	Operation_Op_name = map[int32]string{
+		0: "OP_UNSPECIFIED",
+		1: "OP_SET",
+		2: "OP_APPEND",
+		3: "OP_INSERT",
+		4: "OP_CLEAR",
+		5: "OP_COPY",
+		6: "OP_MOVE",
+		7: "OP_SWAP",
+		8: "OP_TEST",
+	}
+	Operation_Op_value = map[string]int32{
+		"OP_UNSPECIFIED": 0,
+		"OP_SET":         1,
+		"OP_APPEND":      2,
+		"OP_INSERT":      3,
+		"OP_CLEAR":       4,
+		"OP_COPY":        5,
+		"OP_MOVE":        6,
+		"OP_SWAP":        7,
+		"OP_TEST":        8,
+	}
+)
+
+func (x Operation_Op) Enum() *Operation_Op {
+	p := new(Operation_Op)
+	*p = x
+	return p
+}
+
+func (x Operation_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_protopatch_v1_patch_proto_enumTypes[0].Descriptor()
+}
+
+func (Operation_Op) Type() protoreflect.EnumType {
+	return &file_protopatch_v1_patch_proto_enumTypes[0]
+}
+
+func (x Operation_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation_Op.Descriptor instead.
+func (Operation_Op) EnumDescriptor() ([]byte, []int) {
+	return file_protopatch_v1_patch_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Patch is an ordered list of operations applied one after another.
+type Patch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operations    []*Operation           `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Patch) Reset() {
+	*x = Patch{}
+	mi := &file_protopatch_v1_patch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Patch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patch) ProtoMessage() {}
+
+func (x *Patch) ProtoReflect() protoreflect.Message {
+	mi := &file_protopatch_v1_patch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patch.ProtoReflect.Descriptor instead.
+func (*Patch) Descriptor() ([]byte, []int) {
+	return file_protopatch_v1_patch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Patch) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// Operation is a single step of a patch.
+type Operation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the operation.
+	Op Operation_Op `protobuf:"varint,1,opt,name=op,proto3,enum=protopatch.v1.Operation_Op" json:"op,omitempty"`
+	// Path of the target element. For swap operation it is the first element.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Path of the replacement element for copy and move operations and the path of the second element for swap operation.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Replacement value for set operation, the new value for append and insert operations and the expected value for test operation. Unset payload in set operation clears the target element and in test operation expects the target element not to be populated.
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Operation_Value
+	//	*Operation_Any
+	Payload       isOperation_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_protopatch_v1_patch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_protopatch_v1_patch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_protopatch_v1_patch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Operation) GetOp() Operation_Op {
+	if x != nil {
+		return x.Op
+	}
+	return Operation_OP_UNSPECIFIED
+}
+
+func (x *Operation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Operation) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Operation) GetPayload() isOperation_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Operation) GetValue() *structpb.Value {
+	if x != nil {
+		if x, ok := x.Payload.(*Operation_Value); ok {
+			return x.Value
+		}
+	}
+	return nil
+}
+
+func (x *Operation) GetAny() *anypb.Any {
+	if x != nil {
+		if x, ok := x.Payload.(*Operation_Any); ok {
+			return x.Any
+		}
+	}
+	return nil
+}
+
+type isOperation_Payload interface {
+	isOperation_Payload()
+}
+
+type Operation_Value struct {
+	// Value converted to the type of the target element.
+	Value *structpb.Value `protobuf:"bytes,4,opt,name=value,proto3,oneof"`
+}
+
+type Operation_Any struct {
+	// Message value of the target element.
+	Any *anypb.Any `protobuf:"bytes,5,opt,name=any,proto3,oneof"`
+}
+
+func (*Operation_Value) isOperation_Payload() {}
+
+func (*Operation_Any) isOperation_Payload() {}
+
+var File_protopatch_v1_patch_proto protoreflect.FileDescriptor
+
+var file_protopatch_v1_patch_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x41, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x0a,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x03, 0x61, 0x6e, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x48, 0x00, 0x52, 0x03,
+	0x61, 0x6e, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x4f, 0x50, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x50,
+	0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x50, 0x5f,
+	0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x50, 0x5f, 0x43,
+	0x4c, 0x45, 0x41, 0x52, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x5f, 0x43, 0x4f, 0x50,
+	0x59, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x06,
+	0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x10, 0x07, 0x12, 0x0b, 0x0a,
+	0x07, 0x4f, 0x50, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10, 0x08, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0xb3, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x69, 0x73, 0x68, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02,
+	0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protopatch_v1_patch_proto_rawDescOnce sync.Once
+	file_protopatch_v1_patch_proto_rawDescData = file_protopatch_v1_patch_proto_rawDesc
+)
+
+func file_protopatch_v1_patch_proto_rawDescGZIP() []byte {
+	file_protopatch_v1_patch_proto_rawDescOnce.Do(func() {
+		file_protopatch_v1_patch_proto_rawDescData = protoimpl.X.CompressGZIP(file_protopatch_v1_patch_proto_rawDescData)
+	})
+	return file_protopatch_v1_patch_proto_rawDescData
+}
+
+var file_protopatch_v1_patch_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_protopatch_v1_patch_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protopatch_v1_patch_proto_goTypes = []any{
+	(Operation_Op)(0),      // 0: protopatch.v1.Operation.Op
+	(*Patch)(nil),          // 1: protopatch.v1.Patch
+	(*Operation)(nil),      // 2: protopatch.v1.Operation
+	(*structpb.Value)(nil), // 3: google.protobuf.Value
+	(*anypb.Any)(nil),      // 4: google.protobuf.Any
+}
+var file_protopatch_v1_patch_proto_depIdxs = []int32{
+	2, // 0: protopatch.v1.Patch.operations:type_name -> protopatch.v1.Operation
+	0, // 1: protopatch.v1.Operation.op:type_name -> protopatch.v1.Operation.Op
+	3, // 2: protopatch.v1.Operation.value:type_name -> google.protobuf.Value
+	4, // 3: protopatch.v1.Operation.any:type_name -> google.protobuf.Any
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_protopatch_v1_patch_proto_init() }
+func file_protopatch_v1_patch_proto_init() {
+	if File_protopatch_v1_patch_proto != nil {
+		return
+	}
+	file_protopatch_v1_patch_proto_msgTypes[1].OneofWrappers = []any{
+		(*Operation_Value)(nil),
+		(*Operation_Any)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protopatch_v1_patch_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protopatch_v1_patch_proto_goTypes,
+		DependencyIndexes: file_protopatch_v1_patch_proto_depIdxs,
+		EnumInfos:         file_protopatch_v1_patch_proto_enumTypes,
+		MessageInfos:      file_protopatch_v1_patch_proto_msgTypes,
+	}.Build()
+	File_protopatch_v1_patch_proto = out.File
+	file_protopatch_v1_patch_proto_rawDesc = nil
+	file_protopatch_v1_patch_proto_goTypes = nil
+	file_protopatch_v1_patch_proto_depIdxs = nil
+}
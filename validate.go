@@ -0,0 +1,63 @@
+package protopatch
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValidatePath checks, without any message instance, whether the path may be used by the given operation on messages described by the descriptor. It checks that every segment refers to an existing field, a syntactically valid list index (or filter) or a parseable map key, and that the value pointed by the path allows the operation (for example append operation requires a list field and insert operation requires a list index, as described in spec.md). Wildcards are accepted only for set, clear and append operations (other operations report ErrWildcardNotAllowed error for them) and PathEndOfList only as the last segment of insert operation path.
+//
+// Existence of list indexes and map keys depends on the content of messages and is not checked. Errors are the same as the ones returned when the operation fails for the same reason. ValidatePath is not aware of ContainerTransformer options, so paths descending into transformed containers (for example google.protobuf.Struct values) are reported as invalid.
+func ValidatePath(desc protoreflect.MessageDescriptor, path string, op OpKind) error {
+	switch op {
+	case OpSet, OpAppend, OpInsert, OpClear, OpCopy, OpMove, OpSwap, OpTest:
+	default:
+		return ErrUnknownOperation
+	}
+	if path == "" { // special case - an empty path; operation on the base message
+		switch op {
+		case OpAppend:
+			return ErrAppendToNonList
+		case OpInsert:
+			return ErrInsertToNonList
+		}
+		return nil
+	}
+
+	if op != OpSet && op != OpClear && op != OpAppend {
+		if err := checkNoWildcard(path, op); err != nil {
+			return err
+		}
+	}
+	md, collection := desc, protoreflect.FieldDescriptor(nil)
+	parent := protoreflect.FieldDescriptor(nil) // list or map field containing the value pointed by the last segment
+	for ps := range Path(path).Iter {
+		parent = collection
+		if collection != nil && collection.IsList() {
+			if ps.Value() == PathEndOfList && (op != OpInsert || !ps.IsLast()) {
+				return NewErrInPath(string(ps.PrecedingPath()), ErrNotFound{Kind: "index", Value: ps.Value()})
+			}
+		}
+		var err error
+		if _, md, collection, err = compileSegment(ps, md, collection); err != nil {
+			return err
+		}
+	}
+
+	switch op {
+	case OpAppend:
+		if collection == nil && md == nil {
+			return NewErrInPath(path, ErrAccessToNonContainer)
+		}
+		if collection == nil || !collection.IsList() {
+			return NewErrInPath(path, ErrAppendToNonList)
+		}
+	case OpInsert:
+		if parent == nil || !parent.IsList() {
+			if last := Path(path).Last(); !last.IsFirst() {
+				return NewErrInPath(string(last.PrecedingPath()), ErrInsertToNonList)
+			}
+			return ErrInsertToNonList
+		}
+	}
+	return nil
+}
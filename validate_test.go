@@ -0,0 +1,190 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daishe/protopatch"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+)
+
+func TestValidatePath(t *testing.T) {
+	t.Parallel()
+
+	desc := (&protopatchv1.TestMessage{}).ProtoReflect().Descriptor()
+	base := &protopatchv1.TestMessage{
+		Message: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{}},
+		List:    &protopatchv1.TestList{String_: []string{"a"}, Message: []*protopatchv1.TestMessage{{String_: "a", List: &protopatchv1.TestList{}}}},
+		Map:     &protopatchv1.TestMap{StringToMessage: map[string]*protopatchv1.TestMessage{"a": {}}, Int32ToString: map[int32]string{1: "a"}},
+	}
+
+	tests := []struct {
+		name    string
+		op      protopatch.OpKind
+		path    string
+		value   any
+		wantErr error
+	}{
+		{name: "set-base", op: protopatch.OpSet, path: "", value: &protopatchv1.TestMessage{}},
+		{name: "set-field", op: protopatch.OpSet, path: "message.message.string", value: "x"},
+		{name: "set-field-by-number", op: protopatch.OpSet, path: "17.14", value: "x"},
+		{name: "set-list-item", op: protopatch.OpSet, path: "list.message.-1.string", value: "x"},
+		{name: "set-filtered-list-item", op: protopatch.OpSet, path: "list.message[string=a].string", value: "x"},
+		{name: "set-map-value", op: protopatch.OpSet, path: "map.int32ToString.0x10", value: "x"},
+		{name: "set-wildcard", op: protopatch.OpSet, path: "map.stringToMessage.*.list.string.*", value: "x"},
+		{name: "clear-list", op: protopatch.OpClear, path: "list.string"},
+		{name: "append", op: protopatch.OpAppend, path: "list.message.*.list.string", value: "x"},
+		{name: "insert", op: protopatch.OpInsert, path: "list.string.0", value: "x"},
+		{name: "insert-at-end", op: protopatch.OpInsert, path: "list.string.-", value: "x"},
+		{name: "test", op: protopatch.OpTest, path: "map.stringToMessage.a.int32", value: int32(0)},
+		{name: "swap", op: protopatch.OpSwap, path: "list.message.0.message"},
+		{
+			name:    "unknown-field",
+			op:      protopatch.OpSet,
+			path:    "message.unknown",
+			value:   "x",
+			wantErr: protopatch.NewErrInPath("message", protopatch.ErrNotFound{Kind: "field", Value: "unknown"}),
+		},
+		{
+			name:    "malformed-index",
+			op:      protopatch.OpClear,
+			path:    "list.string.first",
+			wantErr: protopatch.NewErrInPath("list.string", protopatch.ErrNotFound{Kind: "index", Value: "first"}),
+		},
+		{
+			name:    "malformed-map-key",
+			op:      protopatch.OpClear,
+			path:    "map.int32ToString.a",
+			wantErr: protopatch.NewErrInPath("map.int32ToString", protopatch.ErrNotFound{Kind: "key", Value: "a"}),
+		},
+		{
+			name:    "invalid-filter",
+			op:      protopatch.OpClear,
+			path:    "list.message[string]",
+			wantErr: protopatch.NewErrInPath("list.message", protopatch.ErrInvalidFilter{Filter: "[string]"}),
+		},
+		{
+			name:    "descend-into-scalar",
+			op:      protopatch.OpSet,
+			path:    "list.string.0.length",
+			value:   "x",
+			wantErr: protopatch.NewErrInPath("list.string.0", protopatch.ErrAccessToNonContainer),
+		},
+		{
+			name:    "wildcard-in-message",
+			op:      protopatch.OpClear,
+			path:    "message.*",
+			wantErr: protopatch.NewErrInPath("message.*", protopatch.ErrWildcardInNonCollection),
+		},
+		{
+			name:    "wildcard-in-insert",
+			op:      protopatch.OpInsert,
+			path:    "list.string.*",
+			value:   "x",
+			wantErr: protopatch.NewErrInPath("list.string", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpInsert}),
+		},
+		{
+			name:    "wildcard-map-key-in-test",
+			op:      protopatch.OpTest,
+			path:    "map.stringToMessage.*.int32",
+			value:   int32(0),
+			wantErr: protopatch.NewErrInPath("map.stringToMessage", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpTest}),
+		},
+		{
+			name:    "wildcard-map-key-in-move",
+			op:      protopatch.OpMove,
+			path:    "map.stringToMessage.*",
+			wantErr: protopatch.NewErrInPath("map.stringToMessage", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpMove}),
+		},
+		{
+			name:    "wildcard-map-key-in-copy",
+			op:      protopatch.OpCopy,
+			path:    "map.stringToMessage.*.string",
+			wantErr: protopatch.NewErrInPath("map.stringToMessage", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpCopy}),
+		},
+		{name: "escaped-wildcard-map-key-in-swap", op: protopatch.OpSwap, path: `map.stringToMessage.\*.string`},
+		{
+			name:    "end-of-list-in-set",
+			op:      protopatch.OpSet,
+			path:    "list.string.-",
+			value:   "x",
+			wantErr: protopatch.NewErrInPath("list.string", protopatch.ErrNotFound{Kind: "index", Value: "-"}),
+		},
+		{
+			name:    "append-to-base",
+			op:      protopatch.OpAppend,
+			path:    "",
+			value:   "x",
+			wantErr: protopatch.ErrAppendToNonList,
+		},
+		{
+			name:    "append-to-map",
+			op:      protopatch.OpAppend,
+			path:    "map.stringToMessage",
+			value:   &protopatchv1.TestMessage{},
+			wantErr: protopatch.NewErrInPath("map.stringToMessage", protopatch.ErrAppendToNonList),
+		},
+		{
+			name:    "append-to-scalar",
+			op:      protopatch.OpAppend,
+			path:    "message.string",
+			value:   "x",
+			wantErr: protopatch.NewErrInPath("message.string", protopatch.ErrAccessToNonContainer),
+		},
+		{
+			name:    "insert-to-message",
+			op:      protopatch.OpInsert,
+			path:    "message.string",
+			value:   "x",
+			wantErr: protopatch.NewErrInPath("message", protopatch.ErrInsertToNonList),
+		},
+		{
+			name:    "insert-to-map",
+			op:      protopatch.OpInsert,
+			path:    "map.stringToMessage.b",
+			value:   &protopatchv1.TestMessage{},
+			wantErr: protopatch.NewErrInPath("map.stringToMessage", protopatch.ErrInsertToNonList),
+		},
+		{
+			name:    "insert-to-base-field",
+			op:      protopatch.OpInsert,
+			path:    "string",
+			value:   "x",
+			wantErr: protopatch.ErrInsertToNonList,
+		},
+		{
+			name:    "unknown-operation",
+			op:      "unknown",
+			path:    "string",
+			wantErr: protopatch.ErrUnknownOperation,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			err := protopatch.ValidatePath(desc, test.path, test.op)
+			require.Equal(t, test.wantErr, err)
+
+			// operation performed on a message must fail in the same way
+			msg := proto.Clone(base)
+			switch test.op {
+			case protopatch.OpSet:
+				err = protopatch.Set(msg, test.path, test.value)
+			case protopatch.OpAppend:
+				err = protopatch.Append(msg, test.path, test.value)
+			case protopatch.OpInsert:
+				err = protopatch.Insert(msg, test.path, test.value)
+			case protopatch.OpClear:
+				err = protopatch.Clear(msg, test.path)
+			case protopatch.OpTest:
+				err = protopatch.Test(msg, test.path, test.value)
+			default:
+				return
+			}
+			require.Equal(t, test.wantErr, err)
+		})
+	}
+}
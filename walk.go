@@ -0,0 +1,77 @@
+package protopatch
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// SkipContainer is used as a return value from WalkFunc to indicate that elements of the value pointed by the path passed in the call are to be skipped (like filepath.SkipDir). It is not returned as an error by any function.
+var SkipContainer = errors.New("skip this container")
+
+// SkipAll is used as a return value from WalkFunc to indicate that all remaining values are to be skipped. It is not returned as an error by any function.
+var SkipAll = errors.New("skip everything and stop the walk")
+
+// WalkFunc is the type of the function called by Walk for every visited value. Path is the full path of the value, value is the value itself (as returned by Container.Get method) and c is the container holding the value under the last segment of the path.
+//
+// If the function returns SkipContainer, Walk does not descend into the value. If it returns SkipAll, Walk stops and returns nil. Any other non-nil error stops Walk and is returned by it.
+type WalkFunc func(path Path, value any, c Container) error
+
+// Walk visits every populated field, list item and map value of the base message, depth-first, calling fn for each of them. The base message itself is not visited. Message fields are visited in the order of their declaration, list items in the order of their indexes and map values in the order of their keys. Keys in paths are escaped with EscapePathSegment when necessary and message fields are referred by their JSON names.
+//
+// Containers are transformed with the configured ContainerTransformer options and elements of transformed containers are visited when the transformed container implements KeysContainer interface (for example contents of google.protobuf.Struct values when patchstructpb transformers are used). Modifying the walked message within fn is not supported.
+func Walk(base proto.Message, fn WalkFunc, opts ...Option) error {
+	setup := newSetup(opts...)
+	c, err := transformContainer(MessageContainer(base), setup)
+	if err != nil {
+		return err
+	}
+	if err := walk(c, "", fn, setup); err != nil && err != SkipAll {
+		return err
+	}
+	return nil
+}
+
+func walk(c Container, path Path, fn WalkFunc, setup *setup) error {
+	for _, key := range walkKeys(c) {
+		p := path.JoinKeys(key)
+		v, err := c.Get(key)
+		if err != nil {
+			return NewErrInPath(string(p), err)
+		}
+		if err := fn(p, v, c); err == SkipContainer {
+			continue
+		} else if err != nil {
+			return err
+		}
+		next, err := accessOnce(c, p.Last(), setup)
+		if errors.Is(err, ErrAccessToNonContainer) { // not a composite value; nothing to descend into
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := walk(next, p, fn, setup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkKeys returns keys of all populated elements of the container.
+func walkKeys(c Container) []string {
+	switch c := c.(type) {
+	case *messageContainer:
+		fields := c.msg.Descriptor().Fields()
+		keys := []string(nil)
+		for i := 0; i < fields.Len(); i++ {
+			if field := fields.Get(i); c.msg.Has(field) {
+				keys = append(keys, field.JSONName())
+			}
+		}
+		return keys
+	case KeysContainer:
+		return c.Keys()
+	}
+	return nil
+}
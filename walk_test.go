@@ -0,0 +1,148 @@
+package protopatch_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func walkTestMessage() *protopatchv1.TestMessage {
+	return &protopatchv1.TestMessage{
+		String_: "a",
+		Message: &protopatchv1.TestMessage{Int32: 1},
+		List:    &protopatchv1.TestList{String_: []string{"a", "b"}},
+		Map:     &protopatchv1.TestMap{StringToString: map[string]string{"b": "c", "a.b": "c"}},
+		WellKnown: &protopatchv1.TestWellKnown{
+			Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"a": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("x")}}),
+			}}),
+		},
+	}
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	skip := func(skipped string, err error) protopatch.WalkFunc {
+		return func(path protopatch.Path, _ any, _ protopatch.Container) error {
+			if string(path) == skipped {
+				return err
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name string
+		fn   protopatch.WalkFunc
+		opts []protopatch.Option
+		want []string
+	}{
+		{
+			name: "all",
+			want: []string{
+				"string",
+				"message", "message.int32",
+				"list", "list.string", "list.string.0", "list.string.1",
+				"map", "map.stringToString", `map.stringToString.a\.b`, "map.stringToString.b",
+				"wellKnown", "wellKnown.value", "wellKnown.value.structValue", "wellKnown.value.structValue.fields",
+				"wellKnown.value.structValue.fields.a", "wellKnown.value.structValue.fields.a.listValue",
+				"wellKnown.value.structValue.fields.a.listValue.values", "wellKnown.value.structValue.fields.a.listValue.values.0",
+				"wellKnown.value.structValue.fields.a.listValue.values.0.stringValue",
+			},
+		},
+		{
+			name: "transformed",
+			opts: []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())},
+			want: []string{
+				"string",
+				"message", "message.int32",
+				"list", "list.string", "list.string.0", "list.string.1",
+				"map", "map.stringToString", `map.stringToString.a\.b`, "map.stringToString.b",
+				"wellKnown", "wellKnown.value", "wellKnown.value.a", "wellKnown.value.a.0",
+			},
+		},
+		{
+			name: "skip-container",
+			fn:   skip("list", protopatch.SkipContainer),
+			want: []string{
+				"string",
+				"message", "message.int32",
+				"list",
+				"map", "map.stringToString", `map.stringToString.a\.b`, "map.stringToString.b",
+				"wellKnown", "wellKnown.value", "wellKnown.value.structValue", "wellKnown.value.structValue.fields",
+				"wellKnown.value.structValue.fields.a", "wellKnown.value.structValue.fields.a.listValue",
+				"wellKnown.value.structValue.fields.a.listValue.values", "wellKnown.value.structValue.fields.a.listValue.values.0",
+				"wellKnown.value.structValue.fields.a.listValue.values.0.stringValue",
+			},
+		},
+		{
+			name: "skip-all",
+			fn:   skip("list.string.0", protopatch.SkipAll),
+			want: []string{
+				"string",
+				"message", "message.int32",
+				"list", "list.string", "list.string.0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := []string(nil)
+			err := protopatch.Walk(walkTestMessage(), func(path protopatch.Path, value any, c protopatch.Container) error {
+				got = append(got, string(path))
+				if test.fn != nil {
+					return test.fn(path, value, c)
+				}
+				return nil
+			}, test.opts...)
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestWalkValues(t *testing.T) {
+	t.Parallel()
+
+	base := walkTestMessage()
+	err := protopatch.Walk(base, func(path protopatch.Path, value any, c protopatch.Container) error {
+		got, err := protopatch.Get(base, string(path))
+		require.NoError(t, err)
+		require.Equal(t, got, value, "value of %q mismatch", path)
+		v, err := c.Get(path.Last().Value())
+		require.NoError(t, err)
+		require.Equal(t, value, v, "value of %q in container mismatch", path)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestWalkError(t *testing.T) {
+	t.Parallel()
+
+	errStop := errors.New("stop")
+	visited := 0
+	err := protopatch.Walk(walkTestMessage(), func(path protopatch.Path, _ any, _ protopatch.Container) error {
+		visited++
+		if path == "message.int32" {
+			return errStop
+		}
+		return nil
+	})
+	require.Equal(t, errStop, err)
+	require.Equal(t, 3, visited)
+
+	err = protopatch.Walk((*protopatchv1.TestMessage)(nil), func(protopatch.Path, any, protopatch.Container) error {
+		return errStop
+	})
+	require.NoError(t, err)
+}
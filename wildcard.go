@@ -0,0 +1,205 @@
+package protopatch
+
+import (
+	"slices"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PathWildcard is a path segment matching every list item or map value of the container it is applied to. Set, clear and append operations with wildcard segments in their paths are performed for every matching element. Other operations address a single element and fail with ErrWildcardNotAllowed error for paths with wildcard segments, so map keys equal to the wildcard must be escaped (see EscapePathSegment).
+const PathWildcard = "*"
+
+// KeysContainer is a container able to enumerate keys of all of its elements. Wildcard path segments can be expanded only within containers implementing this interface.
+type KeysContainer interface {
+	Container
+
+	// Keys returns keys (indexes for lists) of all elements held by the container.
+	Keys() []string
+}
+
+// HasWildcard reports whether any segment of the path is a wildcard or a filter selecting all matching list items (see PathFilter).
+func (p Path) HasWildcard() bool {
+	_, ok := p.firstWildcard()
+	return ok
+}
+
+func (p Path) firstWildcard() (PathSegment, bool) {
+	if p == "" {
+		return PathSegment{}, false
+	}
+	for ps := range p.Iter {
+		if ps.raw() == PathWildcard || isSelectingPathFilter(ps.raw()) {
+			return ps, true
+		}
+	}
+	return PathSegment{}, false
+}
+
+func (c *listContainer) Keys() []string {
+	keys := make([]string, c.li.Len())
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	return keys
+}
+
+func (c *mapContainer) Keys() []string {
+	mks := make([]protoreflect.MapKey, 0, c.ma.Len())
+	c.ma.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		mks = append(mks, k)
+		return true
+	})
+	slices.SortFunc(mks, compareMapKeys)
+	keys := make([]string, len(mks))
+	for i, k := range mks {
+		keys[i] = k.String()
+	}
+	return keys
+}
+
+// checkNoWildcard returns ErrWildcardNotAllowed error (wrapped in ErrInPath error, unless it is the first segment) when any segment of the path is a wildcard. Filters selecting all matching list items are allowed, as operations addressing a single element require them to match exactly one item.
+func checkNoWildcard(path string, op OpKind) error {
+	if path == "" {
+		return nil
+	}
+	for ps := range Path(path).Iter {
+		if ps.raw() == PathWildcard {
+			return inParent(ps, ErrWildcardNotAllowed{Op: op})
+		}
+	}
+	return nil
+}
+
+// expandPath returns all paths produced by replacing wildcard segments of the given path with keys of the matching elements. Paths without wildcards are returned as they are. When reverse is true, the paths are returned in reverse order, so that removing list items one by one does not shift indexes of items removed later.
+func expandPath(base proto.Message, path Path, reverse bool, setup *setup) ([]Path, error) {
+	expanded, err := expandPathInto(nil, base, path, setup)
+	if err != nil {
+		return nil, err
+	}
+	if reverse {
+		slices.Reverse(expanded)
+	}
+	return expanded, nil
+}
+
+func expandPathInto(expanded []Path, base proto.Message, path Path, setup *setup) ([]Path, error) {
+	wildcard, ok := path.firstWildcard()
+	if !ok {
+		return append(expanded, path), nil
+	}
+	c := MessageContainer(base)
+	err := error(nil)
+	if wildcard.IsFirst() {
+		c, err = transformContainer(c, setup)
+	} else {
+		c, err = access(c, wildcard.PrecedingPath(), setup)
+	}
+	if err != nil {
+		return nil, err
+	}
+	keys, err := expandedKeys(c, wildcard.raw())
+	if err != nil {
+		return nil, NewErrInPath(string(wildcard.PrecedingPathWithCurrentSegment()), err)
+	}
+	for _, key := range keys {
+		p := siblingPath(wildcard, key)
+		if !wildcard.IsLast() {
+			p = p.Join(wildcard.FollowingPath())
+		}
+		if expanded, err = expandPathInto(expanded, base, p, setup); err != nil {
+			return nil, err
+		}
+	}
+	return expanded, nil
+}
+
+// expandedKeys returns keys of elements matched by the given wildcard or filter segment.
+func expandedKeys(c Container, segment string) ([]string, error) {
+	if segment == PathWildcard {
+		kc, ok := c.(KeysContainer)
+		if !ok {
+			return nil, ErrWildcardInNonCollection
+		}
+		return kc.Keys(), nil
+	}
+	lc, ok := unwrapContainer(c).(*listContainer)
+	if !ok {
+		return nil, ErrWildcardInNonCollection
+	}
+	f, err := ParsePathFilter(segment)
+	if err != nil {
+		return nil, err
+	}
+	matching := lc.matching(f)
+	keys := make([]string, len(matching))
+	for i, idx := range matching {
+		keys[i] = strconv.Itoa(idx)
+	}
+	return keys, nil
+}
+
+// forEachExpandedPath calls fn for every path produced by expanding wildcards of the given path. All paths are expanded before fn is called for the first time.
+func forEachExpandedPath(base proto.Message, path Path, reverse bool, setup *setup, fn func(Path) error) error {
+	expanded, err := expandPath(base, path, reverse, setup)
+	if err != nil {
+		return err
+	}
+	for _, p := range expanded {
+		if err := checkPath(base, string(p), setup); err != nil {
+			return err
+		}
+	}
+	for _, p := range expanded {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandOperation returns operations produced by expanding wildcards of the operation path, in the order they are applied.
+func expandOperation(base proto.Message, op Operation, setup *setup) ([]Operation, error) {
+	switch op.Op {
+	case OpSet, OpClear, OpAppend:
+	case OpCopy, OpMove, OpSwap:
+		if err := checkNoWildcard(op.Path, op.Op); err != nil {
+			return nil, err
+		}
+		if err := checkNoWildcard(op.From, op.Op); err != nil {
+			return nil, err
+		}
+		return []Operation{op}, nil
+	case OpInsert, OpTest:
+		if err := checkNoWildcard(op.Path, op.Op); err != nil {
+			return nil, err
+		}
+		return []Operation{op}, nil
+	default:
+		return []Operation{op}, nil
+	}
+	if !Path(op.Path).HasWildcard() {
+		return []Operation{op}, nil
+	}
+	expanded, err := expandPath(base, Path(op.Path), op.Op == OpClear || (op.Op == OpSet && op.Value == nil), setup)
+	if err != nil {
+		return nil, err
+	}
+	ops := make([]Operation, len(expanded))
+	for i, p := range expanded {
+		if err := checkPath(base, string(p), setup); err != nil {
+			return nil, err
+		}
+		ops[i] = Operation{Op: op.Op, Path: string(p), Value: op.Value}
+	}
+	return ops, nil
+}
+
+// valueForEach returns value to be stored in one of many elements matched by a wildcard. Messages are cloned, so that matched elements never share them.
+func valueForEach(v any) any {
+	if m, ok := v.(proto.Message); ok {
+		return proto.Clone(m)
+	}
+	return v
+}
@@ -0,0 +1,249 @@
+package protopatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/daishe/protopatch"
+	"github.com/daishe/protopatch/internal/patchtest"
+	protopatchv1 "github.com/daishe/protopatch/internal/testtypes/protopatch/v1"
+	"github.com/daishe/protopatch/patchstructpb"
+)
+
+func TestWildcard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		base    proto.Message
+		patch   protopatch.Patch
+		opts    []protopatch.Option
+		want    proto.Message
+		wantErr error
+	}{
+		{
+			name: "set-list-items",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.string.*", Value: "x"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"x", "x", "x"}}},
+		},
+		{
+			name: "set-field-of-list-items",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "a"}, {Int32: 1}}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message.*.string", Value: "x"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "x"}, {String_: "x", Int32: 1}}}},
+		},
+		{
+			name: "set-message-map-values",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToMessage: map[string]*protopatchv1.TestMessage{"a": {String_: "a"}, "b": {}}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "map.stringToMessage.*", Value: &protopatchv1.TestMessage{Int32: 1}},
+				{Op: protopatch.OpSet, Path: "map.stringToMessage.a.string", Value: "a"},
+			},
+			want: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToMessage: map[string]*protopatchv1.TestMessage{"a": {String_: "a", Int32: 1}, "b": {Int32: 1}}}},
+		},
+		{
+			name: "clear-list-items",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c"}, Int32: []int32{1}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "list.string.*"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Int32: []int32{1}}},
+		},
+		{
+			name: "clear-map-values",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{Int32ToString: map[int32]string{1: "a", 2: "b"}, StringToString: map[string]string{"a": "a"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "map.int32ToString.*"},
+			},
+			want: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a": "a"}}},
+		},
+		{
+			name: "set-nil-clears",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a", "b", "c"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.string.*", Value: nil},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{}},
+		},
+		{
+			name: "append-to-nested-lists",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToMessage: map[string]*protopatchv1.TestMessage{
+				"a": {List: &protopatchv1.TestList{String_: []string{"a"}}},
+				"b": {List: &protopatchv1.TestList{}},
+			}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpAppend, Path: "map.stringToMessage.*.list.string", Value: "x"},
+			},
+			want: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToMessage: map[string]*protopatchv1.TestMessage{
+				"a": {List: &protopatchv1.TestList{String_: []string{"a", "x"}}},
+				"b": {List: &protopatchv1.TestList{String_: []string{"x"}}},
+			}}},
+		},
+		{
+			name: "multiple-wildcards",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{
+				{List: &protopatchv1.TestList{String_: []string{"a", "b"}}},
+				{},
+				{List: &protopatchv1.TestList{String_: []string{"c"}}},
+			}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message.*.list.string.*", Value: "x"},
+			},
+			want: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{
+				{List: &protopatchv1.TestList{String_: []string{"x", "x"}}},
+				{},
+				{List: &protopatchv1.TestList{String_: []string{"x"}}},
+			}}},
+		},
+		{
+			name: "empty-list",
+			base: &protopatchv1.TestMessage{String_: "aaa"},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message.*.string", Value: "x"},
+			},
+			want: &protopatchv1.TestMessage{String_: "aaa"},
+		},
+		{
+			name: "transformed-container",
+			base: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("a"), "b": structpb.NewNullValue()}}),
+			}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "wellKnown.value.*", Value: structpb.NewBoolValue(true)},
+			},
+			opts: []protopatch.Option{protopatch.WithContainerTransformation(patchstructpb.ValueContainerTransformer())},
+			want: &protopatchv1.TestMessage{WellKnown: &protopatchv1.TestWellKnown{
+				Value: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewBoolValue(true), "b": structpb.NewBoolValue(true)}}),
+			}},
+		},
+		{
+			name: "failure-names-expanded-path",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{}, {}}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "list.message.*.unknown", Value: "x"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpSet, Cause: protopatch.NewErrInPath("list.message.0", protopatch.ErrNotFound{Kind: "field", Value: "unknown"})},
+		},
+		{
+			name: "wildcard-in-message",
+			base: &protopatchv1.TestMessage{Message: &protopatchv1.TestMessage{}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpClear, Path: "message.*"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpClear, Cause: protopatch.NewErrInPath("message.*", protopatch.ErrWildcardInNonCollection)},
+		},
+		{
+			name: "escaped-keys",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a.b": "a", "": "b", "*": "c", "[d]": "d"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSet, Path: "map.stringToString.*", Value: "x"},
+			},
+			want: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"a.b": "x", "": "x", "*": "x", "[d]": "x"}}},
+		},
+		{
+			name: "wildcard-in-move",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"*": "a", "b": "b"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpMove, Path: "map.stringToString.*", From: "string"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpMove, Cause: protopatch.NewErrInPath("map.stringToString", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpMove})},
+		},
+		{
+			name: "wildcard-in-copy",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"*": "a", "b": "b"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpCopy, Path: "string", From: "map.stringToString.*"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpCopy, Cause: protopatch.NewErrInPath("map.stringToString", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpCopy})},
+		},
+		{
+			name: "wildcard-in-swap",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"*": "a", "b": "b"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpSwap, Path: "string", From: "map.stringToString.*"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpSwap, Cause: protopatch.NewErrInPath("map.stringToString", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpSwap})},
+		},
+		{
+			name: "wildcard-in-insert",
+			base: &protopatchv1.TestMessage{List: &protopatchv1.TestList{String_: []string{"a"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpInsert, Path: "list.string.*", Value: "x"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpInsert, Cause: protopatch.NewErrInPath("list.string", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpInsert})},
+		},
+		{
+			name: "wildcard-in-test",
+			base: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"*": "a"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpTest, Path: "map.stringToString.*", Value: "a"},
+			},
+			wantErr: protopatch.ErrInOperation{Index: 0, Op: protopatch.OpTest, Cause: protopatch.NewErrInPath("map.stringToString", protopatch.ErrWildcardNotAllowed{Op: protopatch.OpTest})},
+		},
+		{
+			name: "escaped-wildcard-key",
+			base: &protopatchv1.TestMessage{String_: "s", Map: &protopatchv1.TestMap{StringToString: map[string]string{"*": "a", "b": "b"}}},
+			patch: protopatch.Patch{
+				{Op: protopatch.OpTest, Path: `map.stringToString.\*`, Value: "a"},
+				{Op: protopatch.OpSwap, Path: "string", From: `map.stringToString.\*`},
+				{Op: protopatch.OpCopy, Path: "map.stringToString.b", From: `map.stringToString["*"]`},
+				{Op: protopatch.OpMove, Path: `map.stringToString.\*`, From: "string"},
+			},
+			want: &protopatchv1.TestMessage{Map: &protopatchv1.TestMap{StringToString: map[string]string{"*": "a", "b": "s"}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			base := proto.Clone(test.base)
+			err := protopatch.Apply(base, test.patch, test.opts...)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				patchtest.RequireEqual(t, test.base, base, "message modified by failing operation")
+				return
+			}
+			require.NoError(t, err)
+			patchtest.RequireEqual(t, test.want, base, "message after apply mismatch")
+
+			got := proto.Clone(test.base)
+			inverse, err := protopatch.ApplyWithInverse(got, test.patch, test.opts...)
+			require.NoError(t, err)
+			require.NoError(t, protopatch.Apply(got, inverse, test.opts...))
+			patchtest.RequireEqual(t, test.base, got, "message after applying inverse mismatch")
+		})
+	}
+}
+
+func TestWildcardRollback(t *testing.T) {
+	t.Parallel()
+
+	base := &protopatchv1.TestMessage{List: &protopatchv1.TestList{Message: []*protopatchv1.TestMessage{{String_: "a"}, {String_: "b"}}}}
+	patch := protopatch.Patch{
+		{Op: protopatch.OpSet, Path: "list.message.*.string", Value: "x"},
+		{Op: protopatch.OpClear, Path: "list.message.*"},
+		{Op: protopatch.OpClear, Path: "unknown"},
+	}
+
+	got := proto.Clone(base)
+	require.Error(t, protopatch.Apply(got, patch, protopatch.WithRollback()))
+	patchtest.RequireEqual(t, base, got, "message after rollback mismatch")
+}
+
+func TestPathHasWildcard(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, protopatch.Path("").HasWildcard())
+	require.False(t, protopatch.Path("a.b").HasWildcard())
+	require.False(t, protopatch.Path("a.b*").HasWildcard())
+	require.True(t, protopatch.Path("*").HasWildcard())
+	require.True(t, protopatch.Path("a.*.b").HasWildcard())
+}